@@ -1,10 +1,15 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
 
 	"github.com/SAP-cloud-infrastructure/terraform-provider-sci/sci"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 )
 
 const providerAddr = "registry.terraform.io/SAP-cloud-infrastructure/sci"
@@ -15,9 +20,28 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	plugin.Serve(&plugin.ServeOpts{
-		Debug:        debugMode,
-		ProviderAddr: providerAddr,
-		ProviderFunc: sci.Provider,
-	})
+	ctx := context.Background()
+
+	// the SDKv2 provider must be the first one, the framework provider
+	// reuses its configuration
+	sdkProvider := sci.Provider()
+	providers := []func() tfprotov5.ProviderServer{
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(sci.NewFrameworkProvider(sdkProvider)),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf5server.ServeOpt
+	if debugMode {
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+	}
+
+	err = tf5server.Serve(providerAddr, muxServer.ProviderServer, serveOpts...)
+	if err != nil {
+		log.Fatal(err)
+	}
 }
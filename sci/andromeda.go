@@ -37,7 +37,7 @@ func newAndromedaV1(c *Config, eo gophercloud.EndpointOpts) (*client.Andromeda,
 		return nil, fmt.Errorf("parsing the Andromeda URL failed: %s", err)
 	}
 
-	transport := httptransport.New(aurl.Host, aurl.EscapedPath(), []string{aurl.Scheme})
+	transport := httptransport.NewWithClient(aurl.Host, aurl.EscapedPath(), []string{aurl.Scheme}, newServiceHTTPClient(c, "Andromeda"))
 
 	if v, ok := c.OsClient.HTTPClient.Transport.(*osClient.RoundTripper); ok && v.Logger != nil {
 		// enable JSON debug for Andromeda
@@ -51,10 +51,6 @@ func newAndromedaV1(c *Config, eo gophercloud.EndpointOpts) (*client.Andromeda,
 			if err != nil {
 				log.Printf("[DEBUG] Andromeda auth func cannot set X-Auth-Token header value: %v", err)
 			}
-			err = req.SetHeaderParam("User-Agent", c.OsClient.UserAgent.Join())
-			if err != nil {
-				log.Printf("[DEBUG] Andromeda auth func cannot set User-Agent header value: %v", err)
-			}
 			return nil
 		})
 
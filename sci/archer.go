@@ -42,7 +42,7 @@ func newArcherV1(c *Config, eo gophercloud.EndpointOpts) (*archer, error) {
 		return nil, fmt.Errorf("parsing the Archer URL failed: %s", err)
 	}
 
-	transport := httptransport.New(aurl.Host, aurl.EscapedPath(), []string{aurl.Scheme})
+	transport := httptransport.NewWithClient(aurl.Host, aurl.EscapedPath(), []string{aurl.Scheme}, newServiceHTTPClient(c, "Archer"))
 
 	if v, ok := c.OsClient.HTTPClient.Transport.(*osClient.RoundTripper); ok && v.Logger != nil {
 		// enable JSON debug for Archer
@@ -60,11 +60,7 @@ func (a *archer) authFunc() runtime.ClientAuthInfoWriterFunc {
 		func(req runtime.ClientRequest, reg strfmt.Registry) error {
 			err := req.SetHeaderParam("X-Auth-Token", a.provider.Token())
 			if err != nil {
-				log.Printf("[DEBUG] Kubernikus auth func cannot set X-Auth-Token header value: %v", err)
-			}
-			err = req.SetHeaderParam("User-Agent", a.provider.UserAgent.Join())
-			if err != nil {
-				log.Printf("[DEBUG] Kubernikus auth func cannot set User-Agent header value: %v", err)
+				log.Printf("[DEBUG] Archer auth func cannot set X-Auth-Token header value: %v", err)
 			}
 			return nil
 		})
@@ -0,0 +1,105 @@
+package sci
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sapcc/andromeda/client/datacenters"
+	"github.com/sapcc/andromeda/models"
+)
+
+func dataSourceSCIGSLBDatacenterV1() *schema.Resource {
+	s := andromedaDataSourceSchemaV1(resourceSCIGSLBDatacenterV1().Schema,
+		"region", "name", "project_id", "scope", "service_provider",
+	)
+	s["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSCIGSLBDatacenterV1Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceSCIGSLBDatacenterV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating Andromeda client: %s", err)
+	}
+	client := c.Datacenters
+
+	var list []*models.Datacenter
+	if v, ok := d.GetOk("id"); ok {
+		datacenter, err := andromedaGetDatacenter(ctx, client, v.(string))
+		if err != nil {
+			return diag.Errorf("error reading Andromeda datacenter: %s", err)
+		}
+		list = append(list, datacenter)
+	} else {
+		list, err = andromedaListDatacenters(client, andromedaListDatacentersParams(ctx, d))
+		if err != nil {
+			return diag.Errorf("error listing Andromeda datacenters: %s", err)
+		}
+	}
+
+	list = andromedaFilterDatacenters(d, list)
+	if err := andromedaSingleResultV1("datacenter", len(list)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(list[0].ID))
+	andromedaSetDatacenterResource(d, config, list[0])
+
+	return nil
+}
+
+func andromedaListDatacentersParams(ctx context.Context, d *schema.ResourceData) *datacenters.GetDatacentersParams {
+	opts := &datacenters.GetDatacentersParams{
+		Context: ctx,
+	}
+
+	return opts
+}
+
+func andromedaListDatacenters(client datacenters.ClientService, opts *datacenters.GetDatacentersParams) ([]*models.Datacenter, error) {
+	var all []*models.Datacenter
+	for {
+		res, err := client.GetDatacenters(opts)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil || res.Payload == nil {
+			return nil, fmt.Errorf("empty response")
+		}
+
+		all = append(all, res.Payload.Datacenters...)
+		if len(res.Payload.Datacenters) == 0 || !andromedaLinksHaveNext(res.Payload.Links) {
+			break
+		}
+		opts.Marker = &res.Payload.Datacenters[len(res.Payload.Datacenters)-1].ID
+	}
+
+	log.Printf("[DEBUG] Retrieved %d Andromeda datacenters", len(all))
+
+	return all, nil
+}
+
+func andromedaFilterDatacenters(d *schema.ResourceData, list []*models.Datacenter) []*models.Datacenter {
+	var res []*models.Datacenter
+	for _, v := range list {
+		if andromedaMatchV1(d, "name", ptrValue(v.Name)) &&
+			andromedaMatchV1(d, "project_id", ptrValue(v.ProjectID)) &&
+			andromedaMatchV1(d, "scope", ptrValue(v.Scope)) &&
+			andromedaMatchV1(d, "service_provider", v.Provider) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
@@ -0,0 +1,57 @@
+package sci
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSCIGSLBDatacentersV1() *schema.Resource {
+	s := andromedaFilterSchemaV1(resourceSCIGSLBDatacenterV1().Schema,
+		"region", "name", "project_id", "scope", "service_provider",
+	)
+	s["datacenters"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: andromedaListSchemaV1(resourceSCIGSLBDatacenterV1().Schema),
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSCIGSLBDatacentersV1Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceSCIGSLBDatacentersV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating Andromeda client: %s", err)
+	}
+	client := c.Datacenters
+
+	list, err := andromedaListDatacenters(client, andromedaListDatacentersParams(ctx, d))
+	if err != nil {
+		return diag.Errorf("error listing Andromeda datacenters: %s", err)
+	}
+
+	list = andromedaFilterDatacenters(d, list)
+
+	s := andromedaListSchemaV1(resourceSCIGSLBDatacenterV1().Schema)
+	ids := make([]string, len(list))
+	res := make([]map[string]interface{}, len(list))
+	for i, v := range list {
+		ids[i] = string(v.ID)
+		res[i] = andromedaFlattenV1(s, config, ids[i], v, andromedaSetDatacenterResource)
+	}
+
+	d.SetId(andromedaIDsHash(ids))
+	_ = d.Set("ids", ids)
+	_ = d.Set("datacenters", res)
+	_ = d.Set("region", GetRegion(d, config))
+
+	return nil
+}
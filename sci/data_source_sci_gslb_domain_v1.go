@@ -0,0 +1,105 @@
+package sci
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sapcc/andromeda/client/domains"
+	"github.com/sapcc/andromeda/models"
+)
+
+func dataSourceSCIGSLBDomainV1() *schema.Resource {
+	s := andromedaDataSourceSchemaV1(resourceSCIGSLBDomainV1().Schema,
+		"region", "name", "fqdn", "project_id", "service_provider",
+	)
+	s["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSCIGSLBDomainV1Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceSCIGSLBDomainV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating Andromeda client: %s", err)
+	}
+	client := c.Domains
+
+	var list []*models.Domain
+	if v, ok := d.GetOk("id"); ok {
+		domain, err := andromedaGetDomain(ctx, client, v.(string))
+		if err != nil {
+			return diag.Errorf("error reading Andromeda domain: %s", err)
+		}
+		list = append(list, domain)
+	} else {
+		list, err = andromedaListDomains(client, andromedaListDomainsParams(ctx, d))
+		if err != nil {
+			return diag.Errorf("error listing Andromeda domains: %s", err)
+		}
+	}
+
+	list = andromedaFilterDomains(d, list)
+	if err := andromedaSingleResultV1("domain", len(list)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(list[0].ID))
+	andromedaSetDomainResource(d, config, list[0])
+
+	return nil
+}
+
+func andromedaListDomainsParams(ctx context.Context, d *schema.ResourceData) *domains.GetDomainsParams {
+	opts := &domains.GetDomainsParams{
+		Context: ctx,
+	}
+
+	return opts
+}
+
+func andromedaListDomains(client domains.ClientService, opts *domains.GetDomainsParams) ([]*models.Domain, error) {
+	var all []*models.Domain
+	for {
+		res, err := client.GetDomains(opts)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil || res.Payload == nil {
+			return nil, fmt.Errorf("empty response")
+		}
+
+		all = append(all, res.Payload.Domains...)
+		if len(res.Payload.Domains) == 0 || !andromedaLinksHaveNext(res.Payload.Links) {
+			break
+		}
+		opts.Marker = &res.Payload.Domains[len(res.Payload.Domains)-1].ID
+	}
+
+	log.Printf("[DEBUG] Retrieved %d Andromeda domains", len(all))
+
+	return all, nil
+}
+
+func andromedaFilterDomains(d *schema.ResourceData, list []*models.Domain) []*models.Domain {
+	var res []*models.Domain
+	for _, v := range list {
+		if andromedaMatchV1(d, "name", ptrValue(v.Name)) &&
+			andromedaMatchV1(d, "fqdn", ptrValue(v.Fqdn).String()) &&
+			andromedaMatchV1(d, "project_id", ptrValue(v.ProjectID)) &&
+			andromedaMatchV1(d, "service_provider", ptrValue(v.Provider)) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
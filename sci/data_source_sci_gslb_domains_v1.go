@@ -0,0 +1,57 @@
+package sci
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSCIGSLBDomainsV1() *schema.Resource {
+	s := andromedaFilterSchemaV1(resourceSCIGSLBDomainV1().Schema,
+		"region", "name", "fqdn", "project_id", "service_provider",
+	)
+	s["domains"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: andromedaListSchemaV1(resourceSCIGSLBDomainV1().Schema),
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSCIGSLBDomainsV1Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceSCIGSLBDomainsV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating Andromeda client: %s", err)
+	}
+	client := c.Domains
+
+	list, err := andromedaListDomains(client, andromedaListDomainsParams(ctx, d))
+	if err != nil {
+		return diag.Errorf("error listing Andromeda domains: %s", err)
+	}
+
+	list = andromedaFilterDomains(d, list)
+
+	s := andromedaListSchemaV1(resourceSCIGSLBDomainV1().Schema)
+	ids := make([]string, len(list))
+	res := make([]map[string]interface{}, len(list))
+	for i, v := range list {
+		ids[i] = string(v.ID)
+		res[i] = andromedaFlattenV1(s, config, ids[i], v, andromedaSetDomainResource)
+	}
+
+	d.SetId(andromedaIDsHash(ids))
+	_ = d.Set("ids", ids)
+	_ = d.Set("domains", res)
+	_ = d.Set("region", GetRegion(d, config))
+
+	return nil
+}
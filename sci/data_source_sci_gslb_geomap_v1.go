@@ -0,0 +1,110 @@
+package sci
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	geomaps "github.com/sapcc/andromeda/client/geographic_maps"
+	"github.com/sapcc/andromeda/models"
+)
+
+func dataSourceSCIGSLBGeoMapV1() *schema.Resource {
+	s := andromedaDataSourceSchemaV1(resourceSCIGSLBGeoMapV1().Schema,
+		"region", "name", "project_id", "scope", "service_provider", "default_datacenter",
+	)
+	s["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSCIGSLBGeoMapV1Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceSCIGSLBGeoMapV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating Andromeda client: %s", err)
+	}
+	client := c.GeographicMaps
+
+	var list []*models.Geomap
+	if v, ok := d.GetOk("id"); ok {
+		geomap, err := andromedaGetGeoMap(ctx, client, v.(string))
+		if err != nil {
+			return diag.Errorf("error reading Andromeda geomap: %s", err)
+		}
+		list = append(list, geomap)
+	} else {
+		list, err = andromedaListGeoMaps(client, andromedaListGeoMapsParams(ctx, d))
+		if err != nil {
+			return diag.Errorf("error listing Andromeda geomaps: %s", err)
+		}
+	}
+
+	list = andromedaFilterGeoMaps(d, list)
+	if err := andromedaSingleResultV1("geomap", len(list)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(list[0].ID))
+	andromedaSetGeoMapResource(d, config, list[0])
+
+	return nil
+}
+
+func andromedaListGeoMapsParams(ctx context.Context, d *schema.ResourceData) *geomaps.GetGeomapsParams {
+	opts := &geomaps.GetGeomapsParams{
+		Context: ctx,
+	}
+	if v, ok := d.GetOk("default_datacenter"); ok {
+		opts.DefaultDatacenterID = ptr(strfmt.UUID(v.(string)))
+	}
+
+	return opts
+}
+
+func andromedaListGeoMaps(client geomaps.ClientService, opts *geomaps.GetGeomapsParams) ([]*models.Geomap, error) {
+	var all []*models.Geomap
+	for {
+		res, err := client.GetGeomaps(opts)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil || res.Payload == nil {
+			return nil, fmt.Errorf("empty response")
+		}
+
+		all = append(all, res.Payload.Geomaps...)
+		if len(res.Payload.Geomaps) == 0 || !andromedaLinksHaveNext(res.Payload.Links) {
+			break
+		}
+		opts.Marker = &res.Payload.Geomaps[len(res.Payload.Geomaps)-1].ID
+	}
+
+	log.Printf("[DEBUG] Retrieved %d Andromeda geomaps", len(all))
+
+	return all, nil
+}
+
+func andromedaFilterGeoMaps(d *schema.ResourceData, list []*models.Geomap) []*models.Geomap {
+	var res []*models.Geomap
+	for _, v := range list {
+		if andromedaMatchV1(d, "name", ptrValue(v.Name)) &&
+			andromedaMatchV1(d, "project_id", ptrValue(v.ProjectID)) &&
+			andromedaMatchV1(d, "scope", ptrValue(v.Scope)) &&
+			andromedaMatchV1(d, "service_provider", v.Provider) &&
+			andromedaMatchV1(d, "default_datacenter", ptrValue(v.DefaultDatacenter).String()) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
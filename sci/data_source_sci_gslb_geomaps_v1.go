@@ -0,0 +1,57 @@
+package sci
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSCIGSLBGeoMapsV1() *schema.Resource {
+	s := andromedaFilterSchemaV1(resourceSCIGSLBGeoMapV1().Schema,
+		"region", "name", "project_id", "scope", "service_provider", "default_datacenter",
+	)
+	s["geomaps"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: andromedaListSchemaV1(resourceSCIGSLBGeoMapV1().Schema),
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSCIGSLBGeoMapsV1Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceSCIGSLBGeoMapsV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating Andromeda client: %s", err)
+	}
+	client := c.GeographicMaps
+
+	list, err := andromedaListGeoMaps(client, andromedaListGeoMapsParams(ctx, d))
+	if err != nil {
+		return diag.Errorf("error listing Andromeda geomaps: %s", err)
+	}
+
+	list = andromedaFilterGeoMaps(d, list)
+
+	s := andromedaListSchemaV1(resourceSCIGSLBGeoMapV1().Schema)
+	ids := make([]string, len(list))
+	res := make([]map[string]interface{}, len(list))
+	for i, v := range list {
+		ids[i] = string(v.ID)
+		res[i] = andromedaFlattenV1(s, config, ids[i], v, andromedaSetGeoMapResource)
+	}
+
+	d.SetId(andromedaIDsHash(ids))
+	_ = d.Set("ids", ids)
+	_ = d.Set("geomaps", res)
+	_ = d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package sci
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sapcc/andromeda/client/members"
+	"github.com/sapcc/andromeda/models"
+)
+
+func dataSourceSCIGSLBMemberV1() *schema.Resource {
+	s := andromedaDataSourceSchemaV1(resourceSCIGSLBMemberV1().Schema,
+		"region", "name", "project_id", "pool_id", "datacenter_id",
+	)
+	s["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSCIGSLBMemberV1Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceSCIGSLBMemberV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating Andromeda client: %s", err)
+	}
+	client := c.Members
+
+	var list []*models.Member
+	if v, ok := d.GetOk("id"); ok {
+		member, err := andromedaGetMember(ctx, client, v.(string))
+		if err != nil {
+			return diag.Errorf("error reading Andromeda member: %s", err)
+		}
+		list = append(list, member)
+	} else {
+		list, err = andromedaListMembers(client, andromedaListMembersParams(ctx, d))
+		if err != nil {
+			return diag.Errorf("error listing Andromeda members: %s", err)
+		}
+	}
+
+	list = andromedaFilterMembers(d, list)
+	if err := andromedaSingleResultV1("member", len(list)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(list[0].ID))
+	andromedaSetMemberResource(d, config, list[0])
+
+	return nil
+}
+
+func andromedaListMembersParams(ctx context.Context, d *schema.ResourceData) *members.GetMembersParams {
+	opts := &members.GetMembersParams{
+		Context: ctx,
+	}
+	if v, ok := d.GetOk("pool_id"); ok {
+		opts.PoolID = ptr(strfmt.UUID(v.(string)))
+	}
+
+	return opts
+}
+
+func andromedaListMembers(client members.ClientService, opts *members.GetMembersParams) ([]*models.Member, error) {
+	var all []*models.Member
+	for {
+		res, err := client.GetMembers(opts)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil || res.Payload == nil {
+			return nil, fmt.Errorf("empty response")
+		}
+
+		all = append(all, res.Payload.Members...)
+		if len(res.Payload.Members) == 0 || !andromedaLinksHaveNext(res.Payload.Links) {
+			break
+		}
+		opts.Marker = &res.Payload.Members[len(res.Payload.Members)-1].ID
+	}
+
+	log.Printf("[DEBUG] Retrieved %d Andromeda members", len(all))
+
+	return all, nil
+}
+
+func andromedaFilterMembers(d *schema.ResourceData, list []*models.Member) []*models.Member {
+	var res []*models.Member
+	for _, v := range list {
+		if andromedaMatchV1(d, "name", ptrValue(v.Name)) &&
+			andromedaMatchV1(d, "project_id", ptrValue(v.ProjectID)) &&
+			andromedaMatchV1(d, "pool_id", ptrValue(v.PoolID).String()) &&
+			andromedaMatchV1(d, "datacenter_id", ptrValue(v.DatacenterID).String()) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
@@ -0,0 +1,57 @@
+package sci
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSCIGSLBMembersV1() *schema.Resource {
+	s := andromedaFilterSchemaV1(resourceSCIGSLBMemberV1().Schema,
+		"region", "name", "project_id", "pool_id", "datacenter_id",
+	)
+	s["members"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: andromedaListSchemaV1(resourceSCIGSLBMemberV1().Schema),
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSCIGSLBMembersV1Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceSCIGSLBMembersV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating Andromeda client: %s", err)
+	}
+	client := c.Members
+
+	list, err := andromedaListMembers(client, andromedaListMembersParams(ctx, d))
+	if err != nil {
+		return diag.Errorf("error listing Andromeda members: %s", err)
+	}
+
+	list = andromedaFilterMembers(d, list)
+
+	s := andromedaListSchemaV1(resourceSCIGSLBMemberV1().Schema)
+	ids := make([]string, len(list))
+	res := make([]map[string]interface{}, len(list))
+	for i, v := range list {
+		ids[i] = string(v.ID)
+		res[i] = andromedaFlattenV1(s, config, ids[i], v, andromedaSetMemberResource)
+	}
+
+	d.SetId(andromedaIDsHash(ids))
+	_ = d.Set("ids", ids)
+	_ = d.Set("members", res)
+	_ = d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package sci
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sapcc/andromeda/client/monitors"
+	"github.com/sapcc/andromeda/models"
+)
+
+func dataSourceSCIGSLBMonitorV1() *schema.Resource {
+	s := andromedaDataSourceSchemaV1(resourceSCIGSLBMonitorV1().Schema,
+		"region", "name", "project_id", "pool_id",
+	)
+	s["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSCIGSLBMonitorV1Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceSCIGSLBMonitorV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating Andromeda client: %s", err)
+	}
+	client := c.Monitors
+
+	var list []*models.Monitor
+	if v, ok := d.GetOk("id"); ok {
+		monitor, err := andromedaGetMonitor(ctx, client, v.(string))
+		if err != nil {
+			return diag.Errorf("error reading Andromeda monitor: %s", err)
+		}
+		list = append(list, monitor)
+	} else {
+		list, err = andromedaListMonitors(client, andromedaListMonitorsParams(ctx, d))
+		if err != nil {
+			return diag.Errorf("error listing Andromeda monitors: %s", err)
+		}
+	}
+
+	list = andromedaFilterMonitors(d, list)
+	if err := andromedaSingleResultV1("monitor", len(list)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(list[0].ID))
+	andromedaSetMonitorResource(d, config, list[0])
+
+	return nil
+}
+
+func andromedaListMonitorsParams(ctx context.Context, d *schema.ResourceData) *monitors.GetMonitorsParams {
+	opts := &monitors.GetMonitorsParams{
+		Context: ctx,
+	}
+	if v, ok := d.GetOk("pool_id"); ok {
+		opts.PoolID = ptr(strfmt.UUID(v.(string)))
+	}
+
+	return opts
+}
+
+func andromedaListMonitors(client monitors.ClientService, opts *monitors.GetMonitorsParams) ([]*models.Monitor, error) {
+	var all []*models.Monitor
+	for {
+		res, err := client.GetMonitors(opts)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil || res.Payload == nil {
+			return nil, fmt.Errorf("empty response")
+		}
+
+		all = append(all, res.Payload.Monitors...)
+		if len(res.Payload.Monitors) == 0 || !andromedaLinksHaveNext(res.Payload.Links) {
+			break
+		}
+		opts.Marker = &res.Payload.Monitors[len(res.Payload.Monitors)-1].ID
+	}
+
+	log.Printf("[DEBUG] Retrieved %d Andromeda monitors", len(all))
+
+	return all, nil
+}
+
+func andromedaFilterMonitors(d *schema.ResourceData, list []*models.Monitor) []*models.Monitor {
+	var res []*models.Monitor
+	for _, v := range list {
+		if andromedaMatchV1(d, "name", ptrValue(v.Name)) &&
+			andromedaMatchV1(d, "project_id", ptrValue(v.ProjectID)) &&
+			andromedaMatchV1(d, "pool_id", ptrValue(v.PoolID).String()) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
@@ -0,0 +1,57 @@
+package sci
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSCIGSLBMonitorsV1() *schema.Resource {
+	s := andromedaFilterSchemaV1(resourceSCIGSLBMonitorV1().Schema,
+		"region", "name", "project_id", "pool_id",
+	)
+	s["monitors"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: andromedaListSchemaV1(resourceSCIGSLBMonitorV1().Schema),
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSCIGSLBMonitorsV1Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceSCIGSLBMonitorsV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating Andromeda client: %s", err)
+	}
+	client := c.Monitors
+
+	list, err := andromedaListMonitors(client, andromedaListMonitorsParams(ctx, d))
+	if err != nil {
+		return diag.Errorf("error listing Andromeda monitors: %s", err)
+	}
+
+	list = andromedaFilterMonitors(d, list)
+
+	s := andromedaListSchemaV1(resourceSCIGSLBMonitorV1().Schema)
+	ids := make([]string, len(list))
+	res := make([]map[string]interface{}, len(list))
+	for i, v := range list {
+		ids[i] = string(v.ID)
+		res[i] = andromedaFlattenV1(s, config, ids[i], v, andromedaSetMonitorResource)
+	}
+
+	d.SetId(andromedaIDsHash(ids))
+	_ = d.Set("ids", ids)
+	_ = d.Set("monitors", res)
+	_ = d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package sci
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sapcc/andromeda/client/pools"
+	"github.com/sapcc/andromeda/models"
+)
+
+func dataSourceSCIGSLBPoolV1() *schema.Resource {
+	s := andromedaDataSourceSchemaV1(andromedaPoolDataSourceSchemaV1(),
+		"region", "name", "project_id",
+	)
+	s["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+	s["domain_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSCIGSLBPoolV1Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceSCIGSLBPoolV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating Andromeda client: %s", err)
+	}
+	client := c.Pools
+
+	var list []*models.Pool
+	if v, ok := d.GetOk("id"); ok {
+		pool, err := andromedaGetPool(ctx, client, v.(string))
+		if err != nil {
+			return diag.Errorf("error reading Andromeda pool: %s", err)
+		}
+		list = append(list, pool)
+	} else {
+		list, err = andromedaListPools(client, andromedaListPoolsParams(ctx, d))
+		if err != nil {
+			return diag.Errorf("error listing Andromeda pools: %s", err)
+		}
+	}
+
+	list = andromedaFilterPools(d, list)
+	if err := andromedaSingleResultV1("pool", len(list)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(list[0].ID))
+	andromedaSetPoolResource(d, config, list[0])
+
+	return nil
+}
+
+func andromedaListPoolsParams(ctx context.Context, d *schema.ResourceData) *pools.GetPoolsParams {
+	opts := &pools.GetPoolsParams{
+		Context: ctx,
+	}
+	if v, ok := d.GetOk("domain_id"); ok {
+		opts.DomainID = ptr(strfmt.UUID(v.(string)))
+	}
+
+	return opts
+}
+
+func andromedaListPools(client pools.ClientService, opts *pools.GetPoolsParams) ([]*models.Pool, error) {
+	var all []*models.Pool
+	for {
+		res, err := client.GetPools(opts)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil || res.Payload == nil {
+			return nil, fmt.Errorf("empty response")
+		}
+
+		all = append(all, res.Payload.Pools...)
+		if len(res.Payload.Pools) == 0 || !andromedaLinksHaveNext(res.Payload.Links) {
+			break
+		}
+		opts.Marker = &res.Payload.Pools[len(res.Payload.Pools)-1].ID
+	}
+
+	log.Printf("[DEBUG] Retrieved %d Andromeda pools", len(all))
+
+	return all, nil
+}
+
+func andromedaFilterPools(d *schema.ResourceData, list []*models.Pool) []*models.Pool {
+	var res []*models.Pool
+	for _, v := range list {
+		if andromedaMatchV1(d, "name", ptrValue(v.Name)) &&
+			andromedaMatchV1(d, "project_id", ptrValue(v.ProjectID)) &&
+			andromedaMatchUUIDsV1(d, "domain_id", v.Domains) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// andromedaPoolDataSourceSchemaV1 returns the pool resource schema without the
+// inline member and monitor blocks.
+func andromedaPoolDataSourceSchemaV1() map[string]*schema.Schema {
+	s := resourceSCIGSLBPoolV1().Schema
+	delete(s, "member")
+	delete(s, "monitor")
+	delete(s, "authoritative")
+	return s
+}
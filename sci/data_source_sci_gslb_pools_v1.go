@@ -0,0 +1,61 @@
+package sci
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSCIGSLBPoolsV1() *schema.Resource {
+	s := andromedaFilterSchemaV1(andromedaPoolDataSourceSchemaV1(),
+		"region", "name", "project_id",
+	)
+	s["domain_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	s["pools"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: andromedaListSchemaV1(andromedaPoolDataSourceSchemaV1()),
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSCIGSLBPoolsV1Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceSCIGSLBPoolsV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating Andromeda client: %s", err)
+	}
+	client := c.Pools
+
+	list, err := andromedaListPools(client, andromedaListPoolsParams(ctx, d))
+	if err != nil {
+		return diag.Errorf("error listing Andromeda pools: %s", err)
+	}
+
+	list = andromedaFilterPools(d, list)
+
+	s := andromedaListSchemaV1(andromedaPoolDataSourceSchemaV1())
+	ids := make([]string, len(list))
+	res := make([]map[string]interface{}, len(list))
+	for i, v := range list {
+		ids[i] = string(v.ID)
+		res[i] = andromedaFlattenV1(s, config, ids[i], v, andromedaSetPoolResource)
+	}
+
+	d.SetId(andromedaIDsHash(ids))
+	_ = d.Set("ids", ids)
+	_ = d.Set("pools", res)
+	_ = d.Set("region", GetRegion(d, config))
+
+	return nil
+}
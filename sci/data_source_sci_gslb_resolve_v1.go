@@ -0,0 +1,369 @@
+package sci
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sapcc/andromeda/client/datacenters"
+	"github.com/sapcc/andromeda/client/members"
+	"github.com/sapcc/andromeda/client/monitors"
+	"github.com/sapcc/andromeda/client/pools"
+	"github.com/sapcc/andromeda/models"
+)
+
+// the reasons, why a member is not answered
+const (
+	andromedaResolveDomainDown     = "domain_admin_state_down"
+	andromedaResolvePoolDown       = "pool_admin_state_down"
+	andromedaResolveMemberDown     = "admin_state_down"
+	andromedaResolveDatacenterDown = "datacenter_admin_state_down"
+	andromedaResolveOffline        = "offline"
+	andromedaResolveGeography      = "geography"
+	andromedaResolveStandbyPool    = "standby_pool"
+)
+
+func dataSourceSCIGSLBResolveV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSCIGSLBResolveV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"geomap_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"country": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  andromedaValidateCountryCodeV1,
+				ConflictsWith: []string{"continent"},
+			},
+
+			"continent": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"AF", "AN", "AS", "EU", "NA", "OC", "SA",
+				}, false),
+				ConflictsWith: []string{"country"},
+			},
+
+			// computed
+			"mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"answers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"member_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pool_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datacenter_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"datacenter_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"excluded": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"member_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pool_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSCIGSLBResolveV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating Andromeda client: %s", err)
+	}
+
+	domainID := d.Get("domain_id").(string)
+	domain, err := andromedaGetDomain(ctx, c.Domains, domainID)
+	if err != nil {
+		return diag.Errorf("error reading Andromeda domain: %s", err)
+	}
+
+	in := &andromedaResolveInputV1{
+		domain:      domain,
+		members:     make(map[strfmt.UUID][]*models.Member),
+		monitors:    make(map[strfmt.UUID][]*models.Monitor),
+		datacenters: make(map[strfmt.UUID]*models.Datacenter),
+		country:     d.Get("country").(string),
+		continent:   d.Get("continent").(string),
+	}
+	if in.country != "" {
+		in.continent = andromedaCountryContinentV1(in.country)
+	}
+
+	mode := ptrValue(domain.Mode)
+	if mode == models.DomainModeGEOGRAPHIC {
+		v, ok := d.GetOk("geomap_id")
+		if !ok {
+			return diag.Errorf("geomap_id is required to resolve the %s domain in the %s mode", domainID, mode)
+		}
+		in.geomap, err = andromedaGetGeoMap(ctx, c.GeographicMaps, v.(string))
+		if err != nil {
+			return diag.Errorf("error reading Andromeda geographical map: %s", err)
+		}
+	}
+
+	for _, id := range domain.Pools {
+		pool, err := andromedaGetPool(ctx, c.Pools, id.String())
+		if err != nil {
+			if _, ok := err.(*pools.GetPoolsPoolIDNotFound); ok {
+				log.Printf("[DEBUG] Andromeda pool %s of the %s domain not found, skipping", id, domainID)
+				continue
+			}
+			return diag.Errorf("error reading Andromeda pool: %s", err)
+		}
+		in.pools = append(in.pools, pool)
+
+		memberOpts := &members.GetMembersParams{
+			PoolID:  ptr(id),
+			Context: ctx,
+		}
+		in.members[id], err = andromedaListMembers(c.Members, memberOpts)
+		if err != nil {
+			return diag.Errorf("error listing Andromeda members of the %s pool: %s", id, err)
+		}
+
+		monitorOpts := &monitors.GetMonitorsParams{
+			PoolID:  ptr(id),
+			Context: ctx,
+		}
+		in.monitors[id], err = andromedaListMonitors(c.Monitors, monitorOpts)
+		if err != nil {
+			return diag.Errorf("error listing Andromeda monitors of the %s pool: %s", id, err)
+		}
+	}
+
+	list, err := andromedaListDatacenters(c.Datacenters, &datacenters.GetDatacentersParams{Context: ctx})
+	if err != nil {
+		return diag.Errorf("error listing Andromeda datacenters: %s", err)
+	}
+	for _, v := range list {
+		in.datacenters[v.ID] = v
+	}
+
+	answers, excluded := andromedaResolveV1(in)
+
+	ids := []string{domainID, in.country, in.continent}
+	var addresses, datacenterIDs []string
+	res := make([]map[string]interface{}, len(answers))
+	for i, v := range answers {
+		dc := ptrValue(v.DatacenterID).String()
+		res[i] = map[string]interface{}{
+			"member_id":     string(v.ID),
+			"pool_id":       ptrValue(v.PoolID).String(),
+			"datacenter_id": dc,
+			"name":          ptrValue(v.Name),
+			"address":       ptrValue(v.Address),
+			"port":          ptrValue(v.Port),
+		}
+		ids = append(ids, string(v.ID))
+		if !strSliceContains(addresses, ptrValue(v.Address)) {
+			addresses = append(addresses, ptrValue(v.Address))
+		}
+		if dc != "" && !strSliceContains(datacenterIDs, dc) {
+			datacenterIDs = append(datacenterIDs, dc)
+		}
+	}
+
+	d.SetId(andromedaIDsHash(ids))
+
+	_ = d.Set("mode", mode)
+	_ = d.Set("continent", in.continent)
+	_ = d.Set("answers", res)
+	_ = d.Set("addresses", addresses)
+	_ = d.Set("datacenter_ids", datacenterIDs)
+	_ = d.Set("excluded", excluded)
+	_ = d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// andromedaResolveInputV1 holds the Andromeda objects, which are used to
+// simulate the domain answer.
+type andromedaResolveInputV1 struct {
+	domain      *models.Domain
+	pools       []*models.Pool
+	members     map[strfmt.UUID][]*models.Member
+	monitors    map[strfmt.UUID][]*models.Monitor
+	datacenters map[strfmt.UUID]*models.Datacenter
+	geomap      *models.Geomap
+	country     string
+	continent   string
+}
+
+// andromedaResolveV1 returns the members, which would be answered for the
+// client location, and the reasons for the excluded members. The members
+// are returned in the domain pool order and sorted by address and port
+// within a pool.
+func andromedaResolveV1(in *andromedaResolveInputV1) ([]*models.Member, []map[string]interface{}) {
+	mode := ptrValue(in.domain.Mode)
+
+	var geoDatacenters []strfmt.UUID
+	if mode == models.DomainModeGEOGRAPHIC && in.geomap != nil {
+		geoDatacenters = andromedaResolveGeoMapV1(in.geomap, in.country, in.continent)
+	}
+
+	var answers []*models.Member
+	var excluded []map[string]interface{}
+	var activePool strfmt.UUID
+	for _, pool := range in.pools {
+		// the member status is evaluated only, when the pool is monitored
+		var monitored bool
+		for _, m := range in.monitors[pool.ID] {
+			if andromedaAdminStateUp(m.AdminStateUp) {
+				monitored = true
+			}
+		}
+
+		list := append([]*models.Member(nil), in.members[pool.ID]...)
+		sort.SliceStable(list, func(i, j int) bool {
+			return andromedaPoolMemberKey(ptrValue(list[i].Address), ptrValue(list[i].Port)) <
+				andromedaPoolMemberKey(ptrValue(list[j].Address), ptrValue(list[j].Port))
+		})
+
+		for _, m := range list {
+			var reason string
+			dc := in.datacenters[ptrValue(m.DatacenterID)]
+			switch {
+			case !andromedaAdminStateUp(in.domain.AdminStateUp):
+				reason = andromedaResolveDomainDown
+			case !andromedaAdminStateUp(pool.AdminStateUp):
+				reason = andromedaResolvePoolDown
+			case !andromedaAdminStateUp(m.AdminStateUp):
+				reason = andromedaResolveMemberDown
+			case dc != nil && !andromedaAdminStateUp(dc.AdminStateUp):
+				reason = andromedaResolveDatacenterDown
+			case monitored && m.Status == models.MemberStatusOFFLINE:
+				reason = andromedaResolveOffline
+			case geoDatacenters != nil && !sliceContains(geoDatacenters, ptrValue(m.DatacenterID)):
+				reason = andromedaResolveGeography
+			case mode == models.DomainModeAVAILABILITY && activePool != "" && activePool != pool.ID:
+				reason = andromedaResolveStandbyPool
+			}
+
+			if reason != "" {
+				excluded = append(excluded, map[string]interface{}{
+					"member_id": string(m.ID),
+					"pool_id":   string(pool.ID),
+					"reason":    reason,
+				})
+				continue
+			}
+
+			activePool = pool.ID
+			answers = append(answers, m)
+		}
+	}
+
+	return answers, excluded
+}
+
+// andromedaResolveGeoMapV1 returns the datacenters assigned to the client
+// country or to the countries of the client continent. The default datacenter
+// is returned, when there is no assignment. A nil result means, that the
+// answer is not restricted to any datacenter.
+func andromedaResolveGeoMapV1(geomap *models.Geomap, country, continent string) []strfmt.UUID {
+	var res []strfmt.UUID
+	for _, a := range geomap.Assignments {
+		if a == nil {
+			continue
+		}
+		if country != "" && a.Country != country {
+			continue
+		}
+		if country == "" && (continent == "" || andromedaCountryContinentV1(a.Country) != continent) {
+			continue
+		}
+		if !sliceContains(res, a.Datacenter) {
+			res = append(res, a.Datacenter)
+		}
+	}
+
+	// without a default datacenter the answer is not restricted
+	if len(res) == 0 && geomap.DefaultDatacenter != nil {
+		res = append(res, *geomap.DefaultDatacenter)
+	}
+
+	return res
+}
+
+// andromedaAdminStateUp returns the admin state, which defaults to true.
+func andromedaAdminStateUp(v *bool) bool {
+	return v == nil || *v
+}
@@ -0,0 +1,222 @@
+package sci
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/tokens"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSCIIdentityAuthScopeV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSCIIdentityAuthScopeV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// computed
+			"user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_domain_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_domain_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"role_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"service_catalog": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoints": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"region_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"interface": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSCIIdentityAuthScopeV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	tokenDetails, err := getTokenDetails(ctx, identityClient)
+	if err != nil {
+		return diag.Errorf("Error getting token details: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved token details for %s auth scope", d.Get("name").(string))
+	d.SetId(d.Get("name").(string))
+
+	if tokenDetails.user != nil {
+		_ = d.Set("user_name", tokenDetails.user.Name)
+		_ = d.Set("user_id", tokenDetails.user.ID)
+		_ = d.Set("user_domain_name", tokenDetails.user.Domain.Name)
+		_ = d.Set("user_domain_id", tokenDetails.user.Domain.ID)
+	}
+
+	if tokenDetails.domain != nil {
+		_ = d.Set("domain_name", tokenDetails.domain.Name)
+		_ = d.Set("domain_id", tokenDetails.domain.ID)
+	}
+
+	if tokenDetails.project != nil {
+		_ = d.Set("project_name", tokenDetails.project.Name)
+		_ = d.Set("project_id", tokenDetails.project.ID)
+		_ = d.Set("project_domain_name", tokenDetails.project.Domain.Name)
+		_ = d.Set("project_domain_id", tokenDetails.project.Domain.ID)
+	}
+
+	roles, roleNames := flattenIdentityAuthScopeV3Roles(tokenDetails.roles)
+	_ = d.Set("roles", roles)
+	_ = d.Set("role_names", roleNames)
+
+	if tokenDetails.catalog != nil {
+		_ = d.Set("service_catalog", flattenIdentityAuthScopeV3ServiceCatalog(tokenDetails.catalog))
+	}
+
+	_ = d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func flattenIdentityAuthScopeV3Roles(roles []tokens.Role) ([]map[string]string, []string) {
+	res := make([]map[string]string, 0, len(roles))
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		res = append(res, map[string]string{
+			"role_id":   role.ID,
+			"role_name": role.Name,
+		})
+		names = append(names, role.Name)
+	}
+	return res, names
+}
+
+func flattenIdentityAuthScopeV3ServiceCatalog(catalog *tokens.ServiceCatalog) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(catalog.Entries))
+	for _, entry := range catalog.Entries {
+		endpoints := make([]map[string]string, 0, len(entry.Endpoints))
+		for _, endpoint := range entry.Endpoints {
+			endpoints = append(endpoints, map[string]string{
+				"id":        endpoint.ID,
+				"region":    endpoint.Region,
+				"region_id": endpoint.RegionID,
+				"interface": endpoint.Interface,
+				"url":       endpoint.URL,
+			})
+		}
+		res = append(res, map[string]interface{}{
+			"id":        entry.ID,
+			"name":      entry.Name,
+			"type":      entry.Type,
+			"endpoints": endpoints,
+		})
+	}
+	return res
+}
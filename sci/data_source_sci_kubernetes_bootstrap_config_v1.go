@@ -0,0 +1,240 @@
+package sci
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sapcc/kubernikus/pkg/api/client/operations"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+	"sigs.k8s.io/yaml"
+)
+
+// the paths of the kubelet bootstrap files used by the Kubernikus node
+// templates
+const (
+	kubernikusBootstrapKubeConfigPath    = "/etc/kubernetes/bootstrap/kubeconfig"
+	kubernikusBootstrapKubeletConfigPath = "/etc/kubernetes/kubelet/config"
+	kubernikusBootstrapKubeletEnvPath    = "/etc/kubernetes/bootstrap/kubelet.env"
+	kubernikusBootstrapKubeletCAPath     = "/etc/kubernetes/certs/kubelet-clients-ca.pem"
+
+	kubernikusNodePoolLabel = "ccloud.sap.com/nodepool"
+)
+
+func dataSourceSCIKubernetesBootstrapConfigV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSCIKubernetesBootstrapConfigV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: kubernikusValidateClusterName,
+			},
+
+			"node_pool": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: kubernikusValidatePoolName,
+			},
+
+			"is_admin": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "cloud-config",
+				ValidateFunc: validation.StringInSlice([]string{
+					"cloud-config", "ignition",
+				}, false),
+			},
+
+			// computed
+			"kubeconfig": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"kubelet_config": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"kubelet_clients_ca": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"kubelet_clients_ca_file": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"node_labels": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"node_taints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"user_data": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceSCIKubernetesBootstrapConfigV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	name := d.Get("name").(string)
+	log.Printf("[KUBERNETES] Reading Kubernikus Kluster %s bootstrap config in project %s", name, config.TenantID)
+
+	klient, err := config.kubernikusV1Client(ctx, GetRegion(d, config), d.Get("is_admin").(bool))
+	if err != nil {
+		return diag.Errorf("Error creating Kubernikus client: %s", err)
+	}
+
+	var nodeLabels, nodeTaints []string
+	pool := d.Get("node_pool").(string)
+	if pool != "" {
+		result, err := klient.ShowCluster(operations.NewShowClusterParams().WithName(name), klient.authFunc())
+		if err != nil {
+			return diag.FromErr(kubernikusHandleErrorV1("Error reading Kubernikus cluster", err))
+		}
+		_, p := kubernikusFindNodePoolV1(result.Payload.Spec.NodePools, pool)
+		if p == nil {
+			return diag.Errorf("Error reading Kubernikus cluster %s: node pool %s is not found", name, pool)
+		}
+		// the same node labels as in the Kubernikus node templates
+		nodeLabels = append([]string{kubernikusNodePoolLabel + "=" + p.Name}, p.Labels...)
+		nodeTaints = p.Taints
+	}
+
+	result, err := klient.GetBootstrapConfig(operations.NewGetBootstrapConfigParams().WithName(name), klient.authFunc())
+	if err != nil {
+		if e, ok := err.(*operations.GetBootstrapConfigDefault); ok && e.Payload != nil {
+			return diag.Errorf("Error reading Kubernikus bootstrap config: %s", e.Payload.Message)
+		}
+		return diag.Errorf("Error reading Kubernikus bootstrap config: %s", err)
+	}
+
+	userData, err := kubernikusRenderBootstrapUserDataV1(d.Get("format").(string), result.Payload, nodeLabels, nodeTaints)
+	if err != nil {
+		return diag.Errorf("Error rendering Kubernikus bootstrap user data: %s", err)
+	}
+
+	if pool != "" {
+		d.SetId(fmt.Sprintf("%s/%s", name, pool))
+	} else {
+		d.SetId(name)
+	}
+
+	_ = d.Set("kubeconfig", result.Payload.Kubeconfig)
+	_ = d.Set("kubelet_config", result.Payload.Config)
+	_ = d.Set("kubelet_clients_ca", result.Payload.KubeletClientsCA)
+	_ = d.Set("kubelet_clients_ca_file", result.Payload.KubeletClientsCAFile)
+	_ = d.Set("node_labels", nodeLabels)
+	_ = d.Set("node_taints", nodeTaints)
+	_ = d.Set("user_data", userData)
+	_ = d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+type kubernikusBootstrapFileV1 struct {
+	path        string
+	permissions int
+	content     string
+}
+
+// kubernikusRenderBootstrapUserDataV1 renders the user data, which writes the
+// kubelet bootstrap files to the node.
+func kubernikusRenderBootstrapUserDataV1(format string, cfg *models.BootstrapConfig, nodeLabels, nodeTaints []string) (string, error) {
+	caPath := cfg.KubeletClientsCAFile
+	if caPath == "" {
+		caPath = kubernikusBootstrapKubeletCAPath
+	}
+
+	files := []kubernikusBootstrapFileV1{
+		{kubernikusBootstrapKubeConfigPath, 0600, cfg.Kubeconfig},
+		{kubernikusBootstrapKubeletConfigPath, 0644, cfg.Config},
+		{caPath, 0644, cfg.KubeletClientsCA},
+		{kubernikusBootstrapKubeletEnvPath, 0644, fmt.Sprintf("KUBELET_NODE_LABELS=%s\nKUBELET_NODE_TAINTS=%s\n",
+			strings.Join(nodeLabels, ","), strings.Join(nodeTaints, ","))},
+	}
+
+	switch format {
+	case "ignition":
+		type ignitionFile struct {
+			Path     string `json:"path"`
+			Mode     int    `json:"mode"`
+			Contents struct {
+				Source string `json:"source"`
+			} `json:"contents"`
+		}
+		var ignition struct {
+			Ignition struct {
+				Version string `json:"version"`
+			} `json:"ignition"`
+			Storage struct {
+				Files []ignitionFile `json:"files"`
+			} `json:"storage"`
+		}
+		ignition.Ignition.Version = "3.3.0"
+		for _, f := range files {
+			v := ignitionFile{Path: f.path, Mode: f.permissions}
+			v.Contents.Source = "data:;base64," + base64.StdEncoding.EncodeToString([]byte(f.content))
+			ignition.Storage.Files = append(ignition.Storage.Files, v)
+		}
+		b, err := json.Marshal(ignition)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	default:
+		type cloudConfigFile struct {
+			Path        string `json:"path"`
+			Permissions string `json:"permissions"`
+			Content     string `json:"content"`
+		}
+		var cloudConfig struct {
+			WriteFiles []cloudConfigFile `json:"write_files"`
+		}
+		for _, f := range files {
+			cloudConfig.WriteFiles = append(cloudConfig.WriteFiles, cloudConfigFile{
+				Path:        f.path,
+				Permissions: fmt.Sprintf("%#o", f.permissions),
+				Content:     f.content,
+			})
+		}
+		b, err := yaml.Marshal(cloudConfig)
+		if err != nil {
+			return "", err
+		}
+		return "#cloud-config\n" + string(b), nil
+	}
+}
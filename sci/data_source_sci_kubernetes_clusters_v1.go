@@ -0,0 +1,223 @@
+package sci
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	goversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sapcc/kubernikus/pkg/api/client/operations"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+func dataSourceSCIKubernetesClustersV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSCIKubernetesClustersV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"is_admin": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"phase": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(models.KlusterPhasePending),
+					string(models.KlusterPhaseCreating),
+					string(models.KlusterPhaseRunning),
+					string(models.KlusterPhaseUpgrading),
+					string(models.KlusterPhaseTerminating),
+				}, false),
+			},
+
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: kubernikusValidateVersionConstraint,
+			},
+
+			// computed
+			"clusters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"apiserver_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"phase": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"node_pool_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"node_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"healthy_node_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"apiserver_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dashboard_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceSCIKubernetesClustersV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	log.Printf("[KUBERNETES] Listing Kubernikus Klusters in project %s", config.TenantID)
+
+	// the filters are not validated, when they are unknown during the plan
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		re, err := regexp.Compile(v.(string))
+		if err != nil {
+			return diag.Errorf("Error parsing name_regex %q: %s", v, err)
+		}
+		nameRegex = re
+	}
+
+	var constraints goversion.Constraints
+	if v, ok := d.GetOk("version"); ok {
+		c, err := goversion.NewConstraint(v.(string))
+		if err != nil {
+			return diag.Errorf("Error parsing version constraint %q: %s", v, err)
+		}
+		constraints = c
+	}
+
+	klient, err := config.kubernikusV1Client(ctx, GetRegion(d, config), d.Get("is_admin").(bool))
+	if err != nil {
+		return diag.Errorf("Error creating Kubernikus client: %s", err)
+	}
+
+	result, err := klient.ListClusters(operations.NewListClustersParams(), klient.authFunc())
+	if err != nil {
+		if e, ok := err.(*operations.ListClustersDefault); ok && e.Payload != nil {
+			return diag.Errorf("Error listing Kubernikus clusters: %s", e.Payload.Message)
+		}
+		return diag.Errorf("Error listing Kubernikus clusters: %s", err)
+	}
+
+	phase := d.Get("phase").(string)
+
+	var clusters []*models.Kluster
+	for _, c := range result.Payload {
+		if nameRegex != nil && !nameRegex.MatchString(c.Name) {
+			continue
+		}
+		if phase != "" && string(c.Status.Phase) != phase {
+			continue
+		}
+		if constraints != nil {
+			v, err := goversion.NewVersion(c.Spec.Version)
+			if err != nil {
+				log.Printf("[DEBUG] Skipping the %s cluster with the invalid %q version: %s", c.Name, c.Spec.Version, err)
+				continue
+			}
+			if !constraints.Check(v) {
+				continue
+			}
+		}
+		clusters = append(clusters, c)
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].Name < clusters[j].Name
+	})
+
+	names := make([]string, 0, len(clusters))
+	for _, c := range clusters {
+		names = append(names, c.Name)
+	}
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(names, ",")))))
+
+	_ = d.Set("clusters", kubernikusFlattenClustersV1(clusters))
+	_ = d.Set("names", names)
+	_ = d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func kubernikusFlattenClustersV1(clusters []*models.Kluster) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(clusters))
+	for _, c := range clusters {
+		var nodes, healthy int64
+		for _, p := range c.Spec.NodePools {
+			nodes += p.Size
+		}
+		for _, p := range c.Status.NodePools {
+			healthy += p.Healthy
+		}
+		res = append(res, map[string]interface{}{
+			"name":               c.Name,
+			"version":            c.Spec.Version,
+			"apiserver_version":  c.Status.ApiserverVersion,
+			"phase":              string(c.Status.Phase),
+			"node_pool_count":    len(c.Spec.NodePools),
+			"node_count":         nodes,
+			"healthy_node_count": healthy,
+			"apiserver_url":      c.Status.Apiserver,
+			"dashboard_url":      c.Status.Dashboard,
+		})
+	}
+	return res
+}
+
+func kubernikusValidateVersionConstraint(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := goversion.NewConstraint(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid version constraint: %s", k, err))
+	}
+	return
+}
@@ -0,0 +1,307 @@
+package sci
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sapcc/kubernikus/pkg/api/client/operations"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	kubernikusKubeConfigAuthCertificate = "client_certificate"
+	kubernikusKubeConfigAuthExec        = "exec"
+
+	kubernikusExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+)
+
+func dataSourceSCIKubernetesKubeConfigV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSCIKubernetesKubeConfigV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"is_admin": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"cluster": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: kubernikusValidateClusterName,
+						},
+						"auth": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  kubernikusKubeConfigAuthCertificate,
+							ValidateFunc: validation.StringInSlice([]string{
+								kubernikusKubeConfigAuthCertificate, kubernikusKubeConfigAuthExec,
+							}, false),
+						},
+						"context_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"cluster_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"user_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"namespace": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"exec_command": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "kubectl",
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"exec_args": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"current_context": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// computed
+			"kube_config_raw": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceSCIKubernetesKubeConfigV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	klient, err := config.kubernikusV1Client(ctx, GetRegion(d, config), d.Get("is_admin").(bool))
+	if err != nil {
+		return diag.Errorf("Error creating Kubernikus client: %s", err)
+	}
+
+	kubeConfig := clientcmdapi.Config{
+		APIVersion: "v1",
+		Kind:       "Config",
+	}
+
+	var names []string
+	for i, v := range d.Get("cluster").([]interface{}) {
+		c := v.(map[string]interface{})
+		name := c["name"].(string)
+		log.Printf("[KUBERNETES] Rendering the kubeconfig of the Kubernikus Kluster %s in project %s", name, config.TenantID)
+
+		cfg, err := kubernikusRenderKubeConfigV1(klient, c)
+		if err != nil {
+			return diag.Errorf("Error rendering the kubeconfig of the Kubernikus cluster %s: %s", name, err)
+		}
+
+		if kubernikusKubeConfigHasContextV1(kubeConfig.Contexts, cfg.Contexts[0].Name) {
+			return diag.Errorf("Error rendering the Kubernikus kubeconfig: cluster.%d: duplicate context name found: %s", i, cfg.Contexts[0].Name)
+		}
+		if kubernikusKubeConfigHasClusterV1(kubeConfig.Clusters, cfg.Clusters[0].Name) {
+			return diag.Errorf("Error rendering the Kubernikus kubeconfig: cluster.%d: duplicate cluster name found: %s", i, cfg.Clusters[0].Name)
+		}
+		if kubernikusKubeConfigHasUserV1(kubeConfig.AuthInfos, cfg.AuthInfos[0].Name) {
+			return diag.Errorf("Error rendering the Kubernikus kubeconfig: cluster.%d: duplicate user name found: %s", i, cfg.AuthInfos[0].Name)
+		}
+
+		kubeConfig.Clusters = append(kubeConfig.Clusters, cfg.Clusters[0])
+		kubeConfig.AuthInfos = append(kubeConfig.AuthInfos, cfg.AuthInfos[0])
+		kubeConfig.Contexts = append(kubeConfig.Contexts, cfg.Contexts[0])
+
+		names = append(names, name)
+	}
+
+	kubeConfig.CurrentContext = kubeConfig.Contexts[0].Name
+	if v, ok := d.GetOk("current_context"); ok {
+		kubeConfig.CurrentContext = v.(string)
+		if !kubernikusKubeConfigHasContextV1(kubeConfig.Contexts, kubeConfig.CurrentContext) {
+			return diag.Errorf("Error rendering the Kubernikus kubeconfig: current_context %q is not found", kubeConfig.CurrentContext)
+		}
+	}
+
+	kubeConfigRaw, err := yaml.Marshal(kubeConfig)
+	if err != nil {
+		return diag.Errorf("Error marshalling the Kubernikus kubeconfig: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(names, ",")))))
+
+	_ = d.Set("current_context", kubeConfig.CurrentContext)
+	_ = d.Set("kube_config_raw", string(kubeConfigRaw))
+	_ = d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// kubernikusRenderKubeConfigV1 returns a kubeconfig with a single cluster,
+// user and context, named according to the cluster block options.
+func kubernikusRenderKubeConfigV1(klient *kubernikus, c map[string]interface{}) (*clientcmdapi.Config, error) {
+	name := c["name"].(string)
+
+	var kubeConfigRaw string
+	if c["auth"].(string) == kubernikusKubeConfigAuthExec {
+		// Kubernikus provides the OIDC credentials only, when dex or the
+		// authentication configuration is enabled
+		credentials, err := klient.GetClusterCredentialsOIDC(operations.NewGetClusterCredentialsOIDCParams().WithName(name), klient.authFunc())
+		if err != nil {
+			if e, ok := err.(*operations.GetClusterCredentialsOIDCDefault); ok && e.Payload != nil {
+				return nil, fmt.Errorf("failed to download Kubernikus OIDC kubeconfig: %s", e.Payload.Message)
+			}
+			return nil, fmt.Errorf("failed to download Kubernikus OIDC kubeconfig: %s", err)
+		}
+		kubeConfigRaw = credentials.Payload.Kubeconfig
+	} else {
+		credentials, err := klient.GetClusterCredentials(operations.NewGetClusterCredentialsParams().WithName(name), klient.authFunc())
+		if err != nil {
+			return nil, fmt.Errorf("failed to download Kubernikus kubeconfig: %s", err)
+		}
+		kubeConfigRaw = credentials.Payload.Kubeconfig
+	}
+
+	var cfg clientcmdapi.Config
+	if err := yaml.Unmarshal([]byte(kubeConfigRaw), &cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal Kubernikus kubeconfig: %s", err)
+	}
+	if len(cfg.Clusters) == 0 || len(cfg.AuthInfos) == 0 {
+		return nil, fmt.Errorf("the Kubernikus kubeconfig has no clusters or users")
+	}
+
+	cluster := cfg.Clusters[0]
+	user := cfg.AuthInfos[0]
+
+	if c["auth"].(string) == kubernikusKubeConfigAuthExec {
+		exec, err := kubernikusExpandKubeConfigExecV1(c, user.AuthInfo.AuthProvider)
+		if err != nil {
+			return nil, err
+		}
+		user.AuthInfo = clientcmdapi.AuthInfo{Exec: exec}
+	}
+
+	if v, ok := c["cluster_name"].(string); ok && v != "" {
+		cluster.Name = v
+	} else {
+		cluster.Name = name
+	}
+	if v, ok := c["user_name"].(string); ok && v != "" {
+		user.Name = v
+	}
+	namedContext := clientcmdapi.NamedContext{
+		Name: name,
+		Context: clientcmdapi.Context{
+			Cluster:  cluster.Name,
+			AuthInfo: user.Name,
+		},
+	}
+	if v, ok := c["context_name"].(string); ok && v != "" {
+		namedContext.Name = v
+	}
+	if v, ok := c["namespace"].(string); ok && v != "" {
+		namedContext.Context.Namespace = v
+	}
+
+	return &clientcmdapi.Config{
+		Clusters:  []clientcmdapi.NamedCluster{cluster},
+		AuthInfos: []clientcmdapi.NamedAuthInfo{user},
+		Contexts:  []clientcmdapi.NamedContext{namedContext},
+	}, nil
+}
+
+// kubernikusExpandKubeConfigExecV1 converts the OIDC auth provider of the
+// Kubernikus kubeconfig into the kubelogin exec credential plugin config.
+func kubernikusExpandKubeConfigExecV1(c map[string]interface{}, provider *clientcmdapi.AuthProviderConfig) (*clientcmdapi.ExecConfig, error) {
+	if provider == nil || provider.Name != "oidc" {
+		return nil, fmt.Errorf("the Kubernikus kubeconfig has no OIDC auth provider")
+	}
+
+	var args []string
+	if v, ok := c["exec_args"].([]interface{}); ok && len(v) > 0 {
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				args = append(args, v)
+			}
+		}
+	} else if c["exec_command"].(string) == "kubectl" {
+		args = []string{"oidc-login", "get-token"}
+	} else {
+		args = []string{"get-token"}
+	}
+
+	args = append(args, "--oidc-issuer-url="+provider.Config["idp-issuer-url"], "--oidc-client-id="+provider.Config["client-id"])
+	if v := provider.Config["client-secret"]; v != "" {
+		args = append(args, "--oidc-client-secret="+v)
+	}
+
+	return &clientcmdapi.ExecConfig{
+		APIVersion:      kubernikusExecAPIVersion,
+		Command:         c["exec_command"].(string),
+		Args:            args,
+		InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+	}, nil
+}
+
+func kubernikusKubeConfigHasContextV1(contexts []clientcmdapi.NamedContext, name string) bool {
+	for _, c := range contexts {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func kubernikusKubeConfigHasClusterV1(clusters []clientcmdapi.NamedCluster, name string) bool {
+	for _, c := range clusters {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func kubernikusKubeConfigHasUserV1(users []clientcmdapi.NamedAuthInfo, name string) bool {
+	for _, u := range users {
+		if u.Name == name {
+			return true
+		}
+	}
+	return false
+}
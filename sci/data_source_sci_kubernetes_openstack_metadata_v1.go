@@ -0,0 +1,288 @@
+package sci
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sapcc/kubernikus/pkg/api/client/operations"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+func dataSourceSCIKubernetesOpenstackMetadataV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSCIKubernetesOpenstackMetadataV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"is_admin": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// computed
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ram": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vcpus": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"images": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"routers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"external_network_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"networks": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"subnets": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"cidr": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"security_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSCIKubernetesOpenstackMetadataV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	klient, err := config.kubernikusV1Client(ctx, GetRegion(d, config), d.Get("is_admin").(bool))
+	if err != nil {
+		return diag.Errorf("Error creating Kubernikus client: %s", err)
+	}
+
+	res, err := klient.GetOpenstackMetadata(operations.NewGetOpenstackMetadataParams(), klient.authFunc())
+	if err != nil {
+		return diag.Errorf("Error fetching Kubernikus OpenStack metadata: %s", err)
+	}
+	if res.Payload == nil {
+		return diag.Errorf("Error fetching Kubernikus OpenStack metadata: empty response")
+	}
+
+	// images are not part of the Kubernikus metadata
+	imgs, err := kubernikusListImagesV1(ctx, config, GetRegion(d, config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	b, err := res.Payload.MarshalBinary()
+	if err != nil {
+		return diag.Errorf("Error marshalling Kubernikus OpenStack metadata: %s", err)
+	}
+	h := sha256.New()
+	h.Write(b)
+	for _, img := range imgs {
+		h.Write([]byte(img.ID))
+	}
+	d.SetId(fmt.Sprintf("%x", h.Sum(nil)))
+
+	_ = d.Set("availability_zones", kubernikusFlattenMetadataAvailabilityZonesV1(res.Payload))
+	_ = d.Set("flavors", kubernikusFlattenMetadataFlavorsV1(res.Payload))
+	_ = d.Set("images", kubernikusFlattenImagesV1(imgs))
+	_ = d.Set("routers", kubernikusFlattenMetadataRoutersV1(res.Payload))
+	_ = d.Set("security_groups", kubernikusFlattenMetadataSecurityGroupsV1(res.Payload))
+	_ = d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func kubernikusFlattenMetadataAvailabilityZonesV1(m *models.OpenstackMetadata) []string {
+	res := make([]string, 0, len(m.AvailabilityZones))
+	for _, az := range m.AvailabilityZones {
+		res = append(res, az.Name)
+	}
+	return res
+}
+
+func kubernikusFlattenMetadataFlavorsV1(m *models.OpenstackMetadata) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(m.Flavors))
+	for _, f := range m.Flavors {
+		res = append(res, map[string]interface{}{
+			"id":    f.ID,
+			"name":  f.Name,
+			"ram":   f.RAM,
+			"vcpus": f.Vcpus,
+		})
+	}
+	return res
+}
+
+func kubernikusFlattenImagesV1(imgs []images.Image) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(imgs))
+	for _, img := range imgs {
+		res = append(res, map[string]interface{}{
+			"id":         img.ID,
+			"name":       img.Name,
+			"created_at": img.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return res
+}
+
+func kubernikusFlattenMetadataRoutersV1(m *models.OpenstackMetadata) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(m.Routers))
+	for _, r := range m.Routers {
+		if r == nil {
+			continue
+		}
+		networks := make([]map[string]interface{}, 0, len(r.Networks))
+		for _, n := range r.Networks {
+			if n == nil {
+				continue
+			}
+			subnets := make([]map[string]interface{}, 0, len(n.Subnets))
+			for _, s := range n.Subnets {
+				if s == nil {
+					continue
+				}
+				subnets = append(subnets, map[string]interface{}{
+					"id":   s.ID,
+					"name": s.Name,
+					"cidr": s.CIDR,
+				})
+			}
+			networks = append(networks, map[string]interface{}{
+				"id":      n.ID,
+				"name":    n.Name,
+				"subnets": subnets,
+			})
+		}
+		res = append(res, map[string]interface{}{
+			"id":                  r.ID,
+			"name":                r.Name,
+			"external_network_id": r.ExternalNetworkID,
+			"networks":            networks,
+		})
+	}
+	return res
+}
+
+func kubernikusFlattenMetadataSecurityGroupsV1(m *models.OpenstackMetadata) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(m.SecurityGroups))
+	for _, sg := range m.SecurityGroups {
+		if sg == nil {
+			continue
+		}
+		res = append(res, map[string]interface{}{
+			"id":   sg.ID,
+			"name": sg.Name,
+		})
+	}
+	return res
+}
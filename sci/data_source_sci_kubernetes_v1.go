@@ -0,0 +1,341 @@
+package sci
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sapcc/kubernikus/pkg/api/client/operations"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+func dataSourceSCIKubernetesV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSCIKubernetesV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: kubernikusValidateClusterName,
+			},
+
+			"is_admin": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// computed
+			"advertise_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"advertise_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"audit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cluster_cidr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"service_cidr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"dns_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"dns_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ssh_public_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"no_cloud": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"dex": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"authentication_configuration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"dashboard": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"backup": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"node_pools": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"flavor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"taint": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"effect": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"custom_root_disk_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allow_reboot": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"allow_replace": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"node_pool_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: kubernikusNodePoolStatusSchemaV1(),
+				},
+			},
+
+			"openstack": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lb_floating_network_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lb_subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"router_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"phase": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"wormhole": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"apiserver_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"dashboard_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"kube_config": {
+				Type:      schema.TypeList,
+				Computed:  true,
+				Sensitive: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_certificate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_key": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"cluster_ca_certificate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"not_before": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"not_after": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"kube_config_raw": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceSCIKubernetesV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	name := d.Get("name").(string)
+	log.Printf("[KUBERNETES] Reading Kubernikus Kluster %s in project %s", name, config.TenantID)
+
+	klient, err := config.kubernikusV1Client(ctx, GetRegion(d, config), d.Get("is_admin").(bool))
+	if err != nil {
+		return diag.Errorf("Error creating Kubernikus client: %s", err)
+	}
+
+	result, err := klient.ShowCluster(operations.NewShowClusterParams().WithName(name), klient.authFunc())
+	if err != nil {
+		if e, ok := err.(*operations.ShowClusterDefault); ok {
+			return diag.Errorf("Error reading Kubernikus cluster: %s", e.Payload.Message)
+		}
+		return diag.Errorf("Error reading Kubernikus cluster: %s", err)
+	}
+
+	d.SetId(result.Payload.Name)
+
+	_ = d.Set("advertise_address", result.Payload.Spec.AdvertiseAddress)
+	_ = d.Set("advertise_port", result.Payload.Spec.AdvertisePort)
+	_ = d.Set("audit", result.Payload.Spec.Audit)
+	_ = d.Set("cluster_cidr", result.Payload.Spec.ClusterCIDR)
+	_ = d.Set("dns_address", result.Payload.Spec.DNSAddress)
+	_ = d.Set("dns_domain", result.Payload.Spec.DNSDomain)
+	_ = d.Set("ssh_public_key", result.Payload.Spec.SSHPublicKey)
+	_ = d.Set("no_cloud", result.Payload.Spec.NoCloud)
+	_ = d.Set("dex", result.Payload.Spec.Dex)
+	_ = d.Set("authentication_configuration", result.Payload.Spec.AuthenticationConfiguration)
+	_ = d.Set("dashboard", result.Payload.Spec.Dashboard)
+	_ = d.Set("backup", result.Payload.Spec.Backup)
+	_ = d.Set("service_cidr", result.Payload.Spec.ServiceCIDR)
+	_ = d.Set("version", result.Payload.Spec.Version)
+	_ = d.Set("phase", result.Payload.Status.Phase)
+	_ = d.Set("wormhole", result.Payload.Status.Wormhole)
+	_ = d.Set("apiserver_url", result.Payload.Status.Apiserver)
+	_ = d.Set("dashboard_url", result.Payload.Status.Dashboard)
+	_ = d.Set("openstack", kubernikusFlattenOpenstackSpecV1(&result.Payload.Spec.Openstack))
+	_ = d.Set("node_pools", kubernikusFlattenNodePoolsV1(result.Payload.Spec.NodePools))
+	_ = d.Set("node_pool_status", kubernikusFlattenNodePoolsStatusV1(result.Payload.Status.NodePools))
+
+	_ = d.Set("region", GetRegion(d, config))
+
+	// if cluster is in pending state, than there are no credentials yet
+	if result.Payload.Status.Phase != models.KlusterPhasePending {
+		kubeConfigRaw, kubeConfig, err := getCredentials(klient, name, "", 0)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_ = d.Set("kube_config", kubeConfig)
+		_ = d.Set("kube_config_raw", kubeConfigRaw)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package sci
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSCIKubernetesVersionsV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSCIKubernetesVersionsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"is_admin": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// computed
+			"available_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"supported_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"default_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"latest_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"latest_patch_versions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"upgrade_paths": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"git_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSCIKubernetesVersionsV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	klient, err := config.kubernikusV1Client(ctx, GetRegion(d, config), d.Get("is_admin").(bool))
+	if err != nil {
+		return diag.Errorf("Error creating Kubernikus client: %s", err)
+	}
+
+	info, err := klient.Info(nil)
+	if err != nil {
+		return diag.Errorf("Error fetching Kubernikus info: %s", err)
+	}
+	if info.Payload == nil {
+		return diag.Errorf("Error fetching Kubernikus info: empty response")
+	}
+
+	b, err := info.Payload.MarshalBinary()
+	if err != nil {
+		return diag.Errorf("Error marshalling Kubernikus info: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256(b)))
+
+	versions := kubernikusSortVersionsV1(info.Payload.AvailableClusterVersions)
+	available := make([]string, 0, len(versions))
+	latestPatch := make(map[string]string)
+	for _, v := range versions {
+		available = append(available, v.Original())
+		s := v.Segments()
+		// versions are sorted, the last one wins
+		latestPatch[fmt.Sprintf("%d.%d", s[0], s[1])] = v.Original()
+	}
+
+	var latest string
+	if len(available) > 0 {
+		latest = available[len(available)-1]
+	}
+
+	supported := make([]string, 0, len(info.Payload.SupportedClusterVersions))
+	for _, v := range kubernikusSortVersionsV1(info.Payload.SupportedClusterVersions) {
+		supported = append(supported, v.Original())
+	}
+
+	_ = d.Set("available_versions", available)
+	_ = d.Set("supported_versions", supported)
+	_ = d.Set("default_version", info.Payload.DefaultClusterVersion)
+	_ = d.Set("latest_version", latest)
+	_ = d.Set("latest_patch_versions", latestPatch)
+	_ = d.Set("upgrade_paths", kubernikusFlattenUpgradePathsV1(versions))
+	_ = d.Set("git_version", info.Payload.GitVersion)
+	_ = d.Set("region", GetRegion(d, config))
+
+	return nil
+}
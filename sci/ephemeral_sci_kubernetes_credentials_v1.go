@@ -0,0 +1,192 @@
+package sci
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapcc/kubernikus/pkg/api/client/operations"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api/v1"
+	"sigs.k8s.io/yaml"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralSCIKubernetesCredentialsV1{}
+
+type ephemeralSCIKubernetesCredentialsV1 struct {
+	config *Config
+}
+
+type ephemeralSCIKubernetesCredentialsV1Model struct {
+	Region               types.String `tfsdk:"region"`
+	Name                 types.String `tfsdk:"name"`
+	IsAdmin              types.Bool   `tfsdk:"is_admin"`
+	OIDC                 types.Bool   `tfsdk:"oidc"`
+	Host                 types.String `tfsdk:"host"`
+	Username             types.String `tfsdk:"username"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	NotBefore            types.String `tfsdk:"not_before"`
+	NotAfter             types.String `tfsdk:"not_after"`
+	KubeConfigRaw        types.String `tfsdk:"kube_config_raw"`
+}
+
+func newEphemeralSCIKubernetesCredentialsV1() ephemeral.EphemeralResource {
+	return &ephemeralSCIKubernetesCredentialsV1{}
+}
+
+func (e *ephemeralSCIKubernetesCredentialsV1) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_credentials_v1"
+}
+
+func (e *ephemeralSCIKubernetesCredentialsV1) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"is_admin": schema.BoolAttribute{
+				Optional: true,
+			},
+			"oidc": schema.BoolAttribute{
+				Optional: true,
+			},
+			"host": schema.StringAttribute{
+				Computed: true,
+			},
+			"username": schema.StringAttribute{
+				Computed: true,
+			},
+			"client_certificate": schema.StringAttribute{
+				Computed: true,
+			},
+			"client_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Computed: true,
+			},
+			"not_before": schema.StringAttribute{
+				Computed: true,
+			},
+			"not_after": schema.StringAttribute{
+				Computed: true,
+			},
+			"kube_config_raw": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralSCIKubernetesCredentialsV1) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expected *Config, got: %T", req.ProviderData))
+		return
+	}
+
+	e.config = config
+}
+
+func (e *ephemeralSCIKubernetesCredentialsV1) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if e.config == nil {
+		resp.Diagnostics.AddError("Unconfigured provider", "The provider must be configured before the credentials can be opened.")
+		return
+	}
+
+	var data ephemeralSCIKubernetesCredentialsV1Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := e.config.Region
+	if v := data.Region.ValueString(); v != "" {
+		region = v
+	}
+	data.Region = types.StringValue(region)
+
+	klient, err := e.config.kubernikusV1Client(ctx, region, data.IsAdmin.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Kubernikus client", err.Error())
+		return
+	}
+
+	name := data.Name.ValueString()
+	var kubeConfigRaw string
+	if data.OIDC.ValueBool() {
+		credentials, err := klient.GetClusterCredentialsOIDC(operations.NewGetClusterCredentialsOIDCParams().WithName(name), klient.authFunc())
+		if err != nil {
+			resp.Diagnostics.AddError("Error downloading Kubernikus OIDC kubeconfig", err.Error())
+			return
+		}
+		kubeConfigRaw = credentials.Payload.Kubeconfig
+	} else {
+		credentials, err := klient.GetClusterCredentials(operations.NewGetClusterCredentialsParams().WithName(name), klient.authFunc())
+		if err != nil {
+			resp.Diagnostics.AddError("Error downloading Kubernikus kubeconfig", err.Error())
+			return
+		}
+		kubeConfigRaw = credentials.Payload.Kubeconfig
+	}
+
+	values, err := kubernikusFlattenKubeConfigValuesV1(kubeConfigRaw, !data.OIDC.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing Kubernikus kubeconfig", err.Error())
+		return
+	}
+
+	data.Host = types.StringValue(values["host"])
+	data.Username = types.StringValue(values["username"])
+	data.ClientCertificate = types.StringValue(values["client_certificate"])
+	data.ClientKey = types.StringValue(values["client_key"])
+	data.ClusterCACertificate = types.StringValue(values["cluster_ca_certificate"])
+	data.NotBefore = types.StringValue(values["not_before"])
+	data.NotAfter = types.StringValue(values["not_after"])
+	data.KubeConfigRaw = types.StringValue(kubeConfigRaw)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// kubernikusFlattenKubeConfigValuesV1 returns the kubeconfig values. The OIDC
+// kubeconfig has no client certificate, therefore the certificate values are
+// only returned, when withCertificate is set.
+func kubernikusFlattenKubeConfigValuesV1(creds string, withCertificate bool) (map[string]string, error) {
+	if withCertificate {
+		kubeConfig, _, err := flattenKubernetesClusterKubeConfig(creds)
+		if err != nil {
+			return nil, err
+		}
+		return kubeConfig[0], nil
+	}
+
+	var cfg clientcmdapi.Config
+	if err := yaml.Unmarshal([]byte(creds), &cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal Kubernikus kubeconfig: %s", err)
+	}
+
+	values := make(map[string]string)
+	for _, v := range cfg.Clusters {
+		values["host"] = v.Cluster.Server
+		values["cluster_ca_certificate"] = base64.StdEncoding.EncodeToString(v.Cluster.CertificateAuthorityData)
+	}
+	for _, v := range cfg.AuthInfos {
+		values["username"] = v.Name
+	}
+
+	return values, nil
+}
@@ -17,6 +17,13 @@ import (
 type kubernikus struct {
 	operations.ClientService
 	provider *gophercloud.ProviderClient
+	events   *kubernikusEventsV1
+	// skipHealthy contains the node pools, which are not waited for to
+	// become healthy
+	skipHealthy map[string]bool
+	// nodePools, when set, keeps the live node pools, which are not managed
+	// by the cluster resource, in the cluster updates
+	nodePools *kubernikusNodePoolsLockV1
 }
 
 func newKubernikusV1(c *Config, eo gophercloud.EndpointOpts) (*kubernikus, error) {
@@ -42,7 +49,7 @@ func newKubernikusV1(c *Config, eo gophercloud.EndpointOpts) (*kubernikus, error
 		return nil, fmt.Errorf("parsing the Kubernikus URL failed: %s", err)
 	}
 
-	transport := httptransport.New(kurl.Host, kurl.EscapedPath(), []string{kurl.Scheme})
+	transport := httptransport.NewWithClient(kurl.Host, kurl.EscapedPath(), []string{kurl.Scheme}, newServiceHTTPClient(c, "Kubernikus"))
 
 	if v, ok := c.OsClient.HTTPClient.Transport.(*osClient.RoundTripper); ok && v.Logger != nil {
 		// enable JSON debug for Kubernikus
@@ -52,7 +59,7 @@ func newKubernikusV1(c *Config, eo gophercloud.EndpointOpts) (*kubernikus, error
 
 	operations := operations.New(transport, strfmt.Default)
 
-	return &kubernikus{operations, c.OsClient}, nil
+	return &kubernikus{operations, c.OsClient, newKubernikusEventsV1(), nil, nil}, nil
 }
 
 func (k *kubernikus) authFunc() runtime.ClientAuthInfoWriterFunc {
@@ -62,10 +69,6 @@ func (k *kubernikus) authFunc() runtime.ClientAuthInfoWriterFunc {
 			if err != nil {
 				log.Printf("[DEBUG] Kubernikus auth func cannot set X-Auth-Token header value: %v", err)
 			}
-			err = req.SetHeaderParam("User-Agent", k.provider.UserAgent.Join())
-			if err != nil {
-				log.Printf("[DEBUG] Kubernikus auth func cannot set User-Agent header value: %v", err)
-			}
 			return nil
 		})
 }
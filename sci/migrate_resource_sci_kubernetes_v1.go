@@ -0,0 +1,369 @@
+package sci
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+func resourceSCIKubernetesV1V1() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: kubernikusValidateClusterName,
+			},
+
+			"is_admin": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"advertise_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"advertise_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 65536),
+			},
+
+			"audit": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"elasticsearch",
+					"swift",
+					"http",
+					"stdout",
+				}, false),
+			},
+
+			"cluster_cidr": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "100.100.0.0/16",
+				ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+					if v == nil || v.(string) == "" {
+						return nil, nil
+					}
+					return validation.IsCIDRNetwork(8, 17)(v, k)
+				},
+			},
+
+			"service_cidr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsCIDRNetwork(8, 24),
+			},
+
+			"dns_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"dns_domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"ssh_public_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"no_cloud": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"dex": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+				Default:  true,
+			},
+
+			"authentication_configuration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: kubernikusValidateAuthConf,
+			},
+
+			"dashboard": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+				Default:  true,
+			},
+
+			"backup": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					models.KlusterSpecBackupOn, models.KlusterSpecBackupOff, models.KlusterSpecBackupExternalAWS,
+				}, false),
+			},
+
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateKubernetesVersion,
+			},
+
+			"node_pools": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: kubernikusValidatePoolName,
+						},
+						"flavor": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"image": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 127),
+						},
+						"availability_zone": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"taints": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"labels": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"custom_root_disk_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(64, 1024),
+						},
+						"config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allow_reboot": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+									"allow_replace": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"openstack": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lb_floating_network_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"network_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"lb_subnet_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"router_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"security_group_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"phase": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"wormhole": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"apiserver_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"dashboard_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"kube_config": {
+				Type:      schema.TypeList,
+				Computed:  true,
+				Sensitive: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_certificate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_key": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"cluster_ca_certificate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"not_before": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"not_after": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"kube_config_raw": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceSCIKubernetesV1StateUpgradeV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if v, ok := rawState["node_pools"].([]interface{}); ok {
+		for _, v := range v {
+			if p, ok := v.(map[string]interface{}); ok {
+				kubernikusUpgradeNodePoolTaintsLabelsV1(p)
+			}
+		}
+	}
+
+	return rawState, nil
+}
+
+// kubernikusUpgradeNodePoolTaintsLabelsV1 converts the node pool "taints" and
+// "labels" string lists into the "taint" blocks and the "labels" map.
+func kubernikusUpgradeNodePoolTaintsLabelsV1(p map[string]interface{}) {
+	var taints, labels []string
+	if v, ok := p["taints"].([]interface{}); ok {
+		taints = expandToStringSlice(v)
+	}
+	if v, ok := p["labels"].([]interface{}); ok {
+		labels = expandToStringSlice(v)
+	}
+
+	taint := make([]interface{}, 0, len(taints))
+	for _, v := range kubernikusFlattenTaintsV1(taints) {
+		taint = append(taint, v)
+	}
+	label := make(map[string]interface{}, len(labels))
+	for k, v := range kubernikusFlattenLabelsV1(labels) {
+		label[k] = v
+	}
+
+	delete(p, "taints")
+	p["taint"] = taint
+	p["labels"] = label
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"runtime/debug"
+	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/utils/v2/terraform/auth"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var version = "dev"
@@ -19,6 +21,11 @@ var version = "dev"
 // Config struct.
 type Config struct {
 	auth.Config
+
+	// APIMaxRetries and APIRetryMaxDelay configure the retries of the
+	// Kubernikus, Andromeda and Archer API requests.
+	APIMaxRetries    int
+	APIRetryMaxDelay time.Duration
 }
 
 // Provider returns a schema.Provider for OpenStack.
@@ -230,6 +237,22 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"api_max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("OS_API_MAX_RETRIES", 5),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  descriptions["api_max_retries"],
+			},
+
+			"api_retry_max_delay": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("OS_API_RETRY_MAX_DELAY", "60s"),
+				ValidateFunc: validateTimeout,
+				Description:  descriptions["api_retry_max_delay"],
+			},
+
 			"endpoint_overrides": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -252,16 +275,36 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"sci_arc_agent_v1":               dataSourceSCIArcAgentV1(),
-			"sci_arc_agent_ids_v1":           dataSourceSCIArcAgentIDsV1(),
-			"sci_arc_job_v1":                 dataSourceSCIArcJobV1(),
-			"sci_arc_job_ids_v1":             dataSourceSCIArcJobIDsV1(),
-			"sci_automation_v1":              dataSourceSCIAutomationV1(),
-			"sci_billing_domain_masterdata":  dataSourceSCIBillingDomainMasterdata(),
-			"sci_billing_project_masterdata": dataSourceSCIBillingProjectMasterdata(),
-			"sci_gslb_services_v1":           dataSourceSCIGSLBServicesV1(),
-			"sci_endpoint_service_v1":        dataSourceSCIEndpointServiceV1(),
-			"sci_networking_router_v2":       dataSourceSCINetworkingRouterV2(),
+			"sci_arc_agent_v1":                     dataSourceSCIArcAgentV1(),
+			"sci_arc_agent_ids_v1":                 dataSourceSCIArcAgentIDsV1(),
+			"sci_arc_job_v1":                       dataSourceSCIArcJobV1(),
+			"sci_arc_job_ids_v1":                   dataSourceSCIArcJobIDsV1(),
+			"sci_automation_v1":                    dataSourceSCIAutomationV1(),
+			"sci_billing_domain_masterdata":        dataSourceSCIBillingDomainMasterdata(),
+			"sci_billing_project_masterdata":       dataSourceSCIBillingProjectMasterdata(),
+			"sci_gslb_datacenter_v1":               dataSourceSCIGSLBDatacenterV1(),
+			"sci_gslb_datacenters_v1":              dataSourceSCIGSLBDatacentersV1(),
+			"sci_gslb_domain_v1":                   dataSourceSCIGSLBDomainV1(),
+			"sci_gslb_domains_v1":                  dataSourceSCIGSLBDomainsV1(),
+			"sci_gslb_geomap_v1":                   dataSourceSCIGSLBGeoMapV1(),
+			"sci_gslb_geomaps_v1":                  dataSourceSCIGSLBGeoMapsV1(),
+			"sci_gslb_member_v1":                   dataSourceSCIGSLBMemberV1(),
+			"sci_gslb_members_v1":                  dataSourceSCIGSLBMembersV1(),
+			"sci_gslb_monitor_v1":                  dataSourceSCIGSLBMonitorV1(),
+			"sci_gslb_monitors_v1":                 dataSourceSCIGSLBMonitorsV1(),
+			"sci_gslb_pool_v1":                     dataSourceSCIGSLBPoolV1(),
+			"sci_gslb_pools_v1":                    dataSourceSCIGSLBPoolsV1(),
+			"sci_gslb_resolve_v1":                  dataSourceSCIGSLBResolveV1(),
+			"sci_gslb_services_v1":                 dataSourceSCIGSLBServicesV1(),
+			"sci_identity_auth_scope_v3":           dataSourceSCIIdentityAuthScopeV3(),
+			"sci_kubernetes_v1":                    dataSourceSCIKubernetesV1(),
+			"sci_kubernetes_versions_v1":           dataSourceSCIKubernetesVersionsV1(),
+			"sci_kubernetes_openstack_metadata_v1": dataSourceSCIKubernetesOpenstackMetadataV1(),
+			"sci_kubernetes_kubeconfig_v1":         dataSourceSCIKubernetesKubeConfigV1(),
+			"sci_kubernetes_bootstrap_config_v1":   dataSourceSCIKubernetesBootstrapConfigV1(),
+			"sci_kubernetes_clusters_v1":           dataSourceSCIKubernetesClustersV1(),
+			"sci_endpoint_service_v1":              dataSourceSCIEndpointServiceV1(),
+			"sci_networking_router_v2":             dataSourceSCINetworkingRouterV2(),
 			// old provider names
 			"ccloud_arc_agent_v1":               dataSourceSCIArcAgentV1(),
 			"ccloud_arc_agent_ids_v1":           dataSourceSCIArcAgentIDsV1(),
@@ -276,27 +319,29 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"sci_arc_agent_bootstrap_v1":     resourceSCIArcAgentBootstrapV1(),
-			"sci_arc_agent_v1":               resourceSCIArcAgentV1(),
-			"sci_arc_job_v1":                 resourceSCIArcJobV1(),
-			"sci_automation_v1":              resourceSCIAutomationV1(),
-			"sci_automation_run_v1":          resourceSCIAutomationRunV1(),
-			"sci_billing_domain_masterdata":  resourceSCIBillingDomainMasterdata(),
-			"sci_billing_project_masterdata": resourceSCIBillingProjectMasterdata(),
-			"sci_kubernetes_v1":              resourceSCIKubernetesV1(),
-			"sci_bgpvpn_interconnection_v2":  resourceSCIBGPVPNInterconnectionV2(),
-			"sci_gslb_datacenter_v1":         resourceSCIGSLBDatacenterV1(),
-			"sci_gslb_domain_v1":             resourceSCIGSLBDomainV1(),
-			"sci_gslb_pool_v1":               resourceSCIGSLBPoolV1(),
-			"sci_gslb_member_v1":             resourceSCIGSLBMemberV1(),
-			"sci_gslb_monitor_v1":            resourceSCIGSLBMonitorV1(),
-			"sci_gslb_quota_v1":              resourceSCIGSLBQuotaV1(),
-			"sci_gslb_geomap_v1":             resourceSCIGSLBGeoMapV1(),
-			"sci_endpoint_service_v1":        resourceSCIEndpointServiceV1(),
-			"sci_endpoint_v1":                resourceSCIEndpointV1(),
-			"sci_endpoint_accept_v1":         resourceSCIEndpointAcceptV1(),
-			"sci_endpoint_quota_v1":          resourceSCIEndpointQuotaV1(),
-			"sci_endpoint_rbac_policy_v1":    resourceSCIEndpointRBACV1(),
+			"sci_arc_agent_bootstrap_v1":          resourceSCIArcAgentBootstrapV1(),
+			"sci_arc_agent_v1":                    resourceSCIArcAgentV1(),
+			"sci_arc_job_v1":                      resourceSCIArcJobV1(),
+			"sci_automation_v1":                   resourceSCIAutomationV1(),
+			"sci_automation_run_v1":               resourceSCIAutomationRunV1(),
+			"sci_billing_domain_masterdata":       resourceSCIBillingDomainMasterdata(),
+			"sci_billing_project_masterdata":      resourceSCIBillingProjectMasterdata(),
+			"sci_kubernetes_v1":                   resourceSCIKubernetesV1(),
+			"sci_kubernetes_node_pool_v1":         resourceSCIKubernetesNodePoolV1(),
+			"sci_bgpvpn_interconnection_v2":       resourceSCIBGPVPNInterconnectionV2(),
+			"sci_gslb_datacenter_v1":              resourceSCIGSLBDatacenterV1(),
+			"sci_gslb_domain_v1":                  resourceSCIGSLBDomainV1(),
+			"sci_gslb_domain_pool_association_v1": resourceSCIGSLBDomainPoolAssociationV1(),
+			"sci_gslb_pool_v1":                    resourceSCIGSLBPoolV1(),
+			"sci_gslb_member_v1":                  resourceSCIGSLBMemberV1(),
+			"sci_gslb_monitor_v1":                 resourceSCIGSLBMonitorV1(),
+			"sci_gslb_quota_v1":                   resourceSCIGSLBQuotaV1(),
+			"sci_gslb_geomap_v1":                  resourceSCIGSLBGeoMapV1(),
+			"sci_endpoint_service_v1":             resourceSCIEndpointServiceV1(),
+			"sci_endpoint_v1":                     resourceSCIEndpointV1(),
+			"sci_endpoint_accept_v1":              resourceSCIEndpointAcceptV1(),
+			"sci_endpoint_quota_v1":               resourceSCIEndpointQuotaV1(),
+			"sci_endpoint_rbac_policy_v1":         resourceSCIEndpointRBACV1(),
 			// old provider names
 			"ccloud_arc_agent_bootstrap_v1":     resourceSCIArcAgentBootstrapV1(),
 			"ccloud_arc_agent_v1":               resourceSCIArcAgentV1(),
@@ -405,6 +450,12 @@ func init() {
 
 		"max_retries": "How many times HTTP connection should be retried until giving up.",
 
+		"api_max_retries": "How many times Kubernikus, Andromeda and Archer API requests should be retried\n" +
+			"on 409, 429, 502, 503 and 504 responses. Defaults to `5`.",
+
+		"api_retry_max_delay": "The maximum delay between the Kubernikus, Andromeda and Archer API request\n" +
+			"retries. Defaults to `60s`.",
+
 		"enable_logging": "Outputs very verbose logs with all calls made to and responses from OpenStack",
 	}
 }
@@ -439,8 +490,13 @@ func configureProvider(ctx context.Context, d *schema.ResourceData, terraformVer
 		Scope: &gophercloud.AuthScope{System: d.Get("system_scope").(bool)},
 	}
 
+	apiRetryMaxDelay, err := time.ParseDuration(d.Get("api_retry_max_delay").(string))
+	if err != nil {
+		return nil, diag.Errorf("failed to parse api_retry_max_delay: %s", err)
+	}
+
 	config := Config{
-		auth.Config{
+		Config: auth.Config{
 			CACertFile:                  d.Get("cacert_file").(string),
 			ClientCertFile:              d.Get("cert").(string),
 			ClientKeyFile:               d.Get("key").(string),
@@ -475,6 +531,8 @@ func configureProvider(ctx context.Context, d *schema.ResourceData, terraformVer
 			MutexKV:                     mutexkv.NewMutexKV(),
 			EnableLogger:                enableLogging,
 		},
+		APIMaxRetries:    d.Get("api_max_retries").(int),
+		APIRetryMaxDelay: apiRetryMaxDelay,
 	}
 
 	v, ok := getOkExists(d, "insecure")
@@ -0,0 +1,117 @@
+package sci
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	provschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// frameworkProvider serves the parts of the provider, which are supported by
+// the terraform-plugin-framework only, e.g. ephemeral resources. It is muxed
+// with the SDKv2 provider and shares its schema and configuration.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+var _ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+
+// NewFrameworkProvider returns the terraform-plugin-framework provider, which
+// is served next to the SDKv2 provider.
+func NewFrameworkProvider(sdkProvider *schema.Provider) provider.Provider {
+	return &frameworkProvider{sdkProvider: sdkProvider}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "sci"
+	resp.Version = version
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	// the muxed providers must have identical schemas
+	resp.Schema, resp.Diagnostics = frameworkProviderSchema(p.sdkProvider.Schema)
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	// the mux server configures the SDKv2 provider first
+	config, ok := p.sdkProvider.Meta().(*Config)
+	if !ok {
+		resp.Diagnostics.AddError("Error configuring provider", "The SDKv2 provider is not configured.")
+		return
+	}
+
+	resp.EphemeralResourceData = config
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newEphemeralSCIKubernetesCredentialsV1,
+	}
+}
+
+// frameworkProviderSchema converts the SDKv2 provider schema into the
+// terraform-plugin-framework provider schema. Unsupported attribute types are
+// reported as error diagnostics.
+func frameworkProviderSchema(s map[string]*schema.Schema) (provschema.Schema, fwdiag.Diagnostics) {
+	var diags fwdiag.Diagnostics
+	attrs := make(map[string]provschema.Attribute, len(s))
+	for k, v := range s {
+		switch v.Type {
+		case schema.TypeString:
+			attrs[k] = provschema.StringAttribute{
+				Required:           v.Required,
+				Optional:           v.Optional,
+				Sensitive:          v.Sensitive,
+				Description:        v.Description,
+				DeprecationMessage: v.Deprecated,
+			}
+		case schema.TypeBool:
+			attrs[k] = provschema.BoolAttribute{
+				Required:           v.Required,
+				Optional:           v.Optional,
+				Sensitive:          v.Sensitive,
+				Description:        v.Description,
+				DeprecationMessage: v.Deprecated,
+			}
+		case schema.TypeInt:
+			attrs[k] = provschema.Int64Attribute{
+				Required:           v.Required,
+				Optional:           v.Optional,
+				Sensitive:          v.Sensitive,
+				Description:        v.Description,
+				DeprecationMessage: v.Deprecated,
+			}
+		case schema.TypeMap:
+			attrs[k] = provschema.MapAttribute{
+				ElementType:        types.StringType,
+				Required:           v.Required,
+				Optional:           v.Optional,
+				Sensitive:          v.Sensitive,
+				Description:        v.Description,
+				DeprecationMessage: v.Deprecated,
+			}
+		default:
+			diags.AddError("Error converting provider schema",
+				fmt.Sprintf("The %q provider attribute has the unsupported %s type.", k, v.Type))
+		}
+	}
+
+	return provschema.Schema{
+		Attributes: attrs,
+	}, diags
+}
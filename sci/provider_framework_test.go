@@ -0,0 +1,50 @@
+package sci
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFrameworkProviderMuxSchema(t *testing.T) {
+	ctx := context.Background()
+
+	sdkProvider := Provider()
+	providers := []func() tfprotov5.ProviderServer{
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(NewFrameworkProvider(sdkProvider)),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		t.Fatalf("Error creating mux server: %s", err)
+	}
+
+	// the mux server reports differing provider schemas as error diagnostics
+	resp, err := muxServer.ProviderServer().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Error getting provider schema: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Errorf("Unexpected error diagnostic: %s: %s", d.Summary, d.Detail)
+		}
+	}
+}
+
+func TestFrameworkProviderSchemaUnsupportedType(t *testing.T) {
+	_, diags := frameworkProviderSchema(map[string]*schema.Schema{
+		"list": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	})
+	if !diags.HasError() {
+		t.Fatal("Expected an error diagnostic for the unsupported attribute type")
+	}
+}
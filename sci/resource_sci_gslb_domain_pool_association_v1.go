@@ -0,0 +1,190 @@
+package sci
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sapcc/andromeda/client/domains"
+	"github.com/sapcc/andromeda/models"
+)
+
+func resourceSCIGSLBDomainPoolAssociationV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSCIGSLBDomainPoolAssociationV1Create,
+		ReadContext:   resourceSCIGSLBDomainPoolAssociationV1Read,
+		DeleteContext: resourceSCIGSLBDomainPoolAssociationV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func resourceSCIGSLBDomainPoolAssociationV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating Andromeda client: %s", err)
+	}
+	client := c.Domains
+
+	domainID := d.Get("domain_id").(string)
+	poolID := strfmt.UUID(d.Get("pool_id").(string))
+	timeout := d.Timeout(schema.TimeoutCreate)
+	err = andromedaModifyDomainPools(ctx, config, client, GetRegion(d, config), domainID, timeout, func(pools []strfmt.UUID) []strfmt.UUID {
+		if sliceContains(pools, poolID) {
+			log.Printf("[DEBUG] Andromeda pool %s is already associated with the %s domain", poolID, domainID)
+			return nil
+		}
+		return append(pools, poolID)
+	})
+	if err != nil {
+		return diag.Errorf("error associating Andromeda pool %s with the %s domain: %s", poolID, domainID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", domainID, poolID))
+
+	return resourceSCIGSLBDomainPoolAssociationV1Read(ctx, d, meta)
+}
+
+func resourceSCIGSLBDomainPoolAssociationV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating Andromeda client: %s", err)
+	}
+	client := c.Domains
+
+	domainID, poolID, err := parsePairedIDs(d.Id(), "sci_gslb_domain_pool_association_v1")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domain, err := andromedaGetDomain(ctx, client, domainID)
+	if err != nil {
+		if _, ok := err.(*domains.GetDomainsDomainIDNotFound); ok {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if !sliceContains(domain.Pools, strfmt.UUID(poolID)) {
+		log.Printf("[DEBUG] Andromeda pool %s is not associated with the %s domain anymore", poolID, domainID)
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("domain_id", domainID)
+	_ = d.Set("pool_id", poolID)
+	_ = d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSCIGSLBDomainPoolAssociationV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating Andromeda client: %s", err)
+	}
+	client := c.Domains
+
+	domainID, poolID, err := parsePairedIDs(d.Id(), "sci_gslb_domain_pool_association_v1")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+	err = andromedaModifyDomainPools(ctx, config, client, GetRegion(d, config), domainID, timeout, func(pools []strfmt.UUID) []strfmt.UUID {
+		if !sliceContains(pools, strfmt.UUID(poolID)) {
+			return nil
+		}
+		return slices.DeleteFunc(pools, func(v strfmt.UUID) bool {
+			return v == strfmt.UUID(poolID)
+		})
+	})
+	if err != nil {
+		if _, ok := err.(*domains.GetDomainsDomainIDNotFound); ok {
+			return nil
+		}
+		return diag.Errorf("error disassociating Andromeda pool %s from the %s domain: %s", poolID, domainID, err)
+	}
+
+	return nil
+}
+
+// andromedaDomainMutexKey returns the MutexKV key, which serializes the
+// read-modify-write operations on the pools of a single domain.
+func andromedaDomainMutexKey(region, id string) string {
+	return fmt.Sprintf("andromeda/domain/%s/%s", region, id)
+}
+
+// andromedaModifyDomainPools performs a read-modify-write of the domain pools
+// under the per-domain lock. The domain is not updated, when the modify
+// function returns nil.
+func andromedaModifyDomainPools(ctx context.Context, config *Config, client domains.ClientService, region, id string, timeout time.Duration, modify func([]strfmt.UUID) []strfmt.UUID) error {
+	key := andromedaDomainMutexKey(region, id)
+	config.MutexKV.Lock(key)
+	defer config.MutexKV.Unlock(key)
+
+	domain, err := andromedaGetDomain(ctx, client, id)
+	if err != nil {
+		return err
+	}
+
+	pools := modify(domain.Pools)
+	if pools == nil {
+		return nil
+	}
+
+	opts := &domains.PutDomainsDomainIDParams{
+		Domain: domains.PutDomainsDomainIDBody{
+			Domain: &models.Domain{
+				Fqdn:     domain.Fqdn,
+				Provider: domain.Provider,
+				Pools:    pools,
+			},
+		},
+		DomainID: strfmt.UUID(id),
+		Context:  ctx,
+	}
+	_, err = client.PutDomainsDomainID(opts)
+	if err != nil {
+		return err
+	}
+
+	// waiting for ACTIVE status
+	target := models.DomainProvisioningStatusACTIVE
+	pending := models.DomainProvisioningStatusPENDINGUPDATE
+	_, err = andromedaWaitForDomain(ctx, client, id, target, pending, timeout)
+
+	return err
+}
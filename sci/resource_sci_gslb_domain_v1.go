@@ -2,6 +2,7 @@ package sci
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/sapcc/andromeda/client/domains"
+	"github.com/sapcc/andromeda/client/members"
 	"github.com/sapcc/andromeda/models"
 )
 
@@ -24,6 +26,7 @@ func resourceSCIGSLBDomainV1() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceSCIGSLBDomainV1CustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"region": {
@@ -112,6 +115,56 @@ func resourceSCIGSLBDomainV1() *schema.Resource {
 	}
 }
 
+func resourceSCIGSLBDomainV1CustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	var errs []error
+	if diff.NewValueKnown("mode") && diff.NewValueKnown("service_provider") {
+		mode := diff.Get("mode").(string)
+		provider := diff.Get("service_provider").(string)
+		if mode == "GEOGRAPHIC" && provider != "akamai" {
+			errs = append(errs, fmt.Errorf("mode: the %s mode is not supported by the %q service provider", mode, provider))
+		}
+	}
+
+	// validate the address family of the pool members
+	if !diff.HasChanges("record_type", "pools") || !diff.NewValueKnown("record_type") || !diff.NewValueKnown("pools") {
+		return errors.Join(errs...)
+	}
+	recordType := diff.Get("record_type").(string)
+	poolIDs := diff.Get("pools").([]interface{})
+	if len(poolIDs) == 0 || (recordType != models.DomainRecordTypeA && recordType != models.DomainRecordTypeAAAA) {
+		return errors.Join(errs...)
+	}
+
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, andromedaDiffRegion(diff, config))
+	if err != nil {
+		return fmt.Errorf("error creating Andromeda client: %s", err)
+	}
+
+	for _, v := range poolIDs {
+		poolID, _ := v.(string)
+		if poolID == "" {
+			continue
+		}
+		opts := &members.GetMembersParams{
+			PoolID:  ptr(strfmt.UUID(poolID)),
+			Context: ctx,
+		}
+		list, err := andromedaListMembers(c.Members, opts)
+		if err != nil {
+			return fmt.Errorf("error listing Andromeda members of the %s pool: %s", poolID, err)
+		}
+		for _, m := range list {
+			address := ptrValue(m.Address)
+			if !andromedaAddressMatchesRecordTypeV1(recordType, address) {
+				errs = append(errs, fmt.Errorf("record_type: the %q address of the %s member in the %s pool doesn't match the %s record type", address, m.ID, poolID, recordType))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func resourceSCIGSLBDomainV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
@@ -2,6 +2,7 @@ package sci
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -24,6 +25,7 @@ func resourceSCIGSLBGeoMapV1() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceSCIGSLBGeoMapV1CustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"region": {
@@ -37,8 +39,9 @@ func resourceSCIGSLBGeoMapV1() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"country": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: andromedaValidateCountryCodeV1,
 						},
 						"datacenter": {
 							Type:     schema.TypeString,
@@ -96,6 +99,23 @@ func resourceSCIGSLBGeoMapV1() *schema.Resource {
 	}
 }
 
+func resourceSCIGSLBGeoMapV1CustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	var errs []error
+	var countries []string
+	for i := range diff.Get("assignments").([]interface{}) {
+		key := fmt.Sprintf("assignments.%d.country", i)
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+		country := diff.Get(key).(string)
+		if strSliceContains(countries, country) {
+			errs = append(errs, fmt.Errorf("%s: duplicate country assignment found: %s", key, country))
+		}
+		countries = append(countries, country)
+	}
+	return errors.Join(errs...)
+}
+
 func resourceSCIGSLBGeoMapV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
@@ -2,8 +2,10 @@ package sci
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -11,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/sapcc/andromeda/client/members"
+	"github.com/sapcc/andromeda/client/pools"
 	"github.com/sapcc/andromeda/models"
 )
 
@@ -23,6 +26,7 @@ func resourceSCIGSLBMemberV1() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceSCIGSLBMemberV1CustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"region": {
@@ -84,6 +88,47 @@ func resourceSCIGSLBMemberV1() *schema.Resource {
 	}
 }
 
+func resourceSCIGSLBMemberV1CustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// validate the address family against the domains of the pool
+	if !diff.HasChanges("address", "pool_id") || !diff.NewValueKnown("address") || !diff.NewValueKnown("pool_id") {
+		return nil
+	}
+	address := diff.Get("address").(string)
+	poolID := diff.Get("pool_id").(string)
+	if poolID == "" || net.ParseIP(address) == nil {
+		return nil
+	}
+
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, andromedaDiffRegion(diff, config))
+	if err != nil {
+		return fmt.Errorf("error creating Andromeda client: %s", err)
+	}
+
+	pool, err := andromedaGetPool(ctx, c.Pools, poolID)
+	if err != nil {
+		if _, ok := err.(*pools.GetPoolsPoolIDNotFound); ok {
+			log.Printf("[DEBUG] Andromeda pool %s not found, skipping the record type validation", poolID)
+			return nil
+		}
+		return fmt.Errorf("error fetching Andromeda pool %s: %s", poolID, err)
+	}
+
+	recordTypes, err := andromedaGetRecordTypesV1(ctx, c.Domains, pool.Domains)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, id := range pool.Domains {
+		if v, ok := recordTypes[id]; ok && !andromedaAddressMatchesRecordTypeV1(v, address) {
+			errs = append(errs, fmt.Errorf("address: the %q address doesn't match the %s record type of the %s domain", address, v, id))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func resourceSCIGSLBMemberV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
@@ -2,6 +2,7 @@ package sci
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -24,6 +25,7 @@ func resourceSCIGSLBMonitorV1() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceSCIGSLBMonitorV1CustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"region": {
@@ -106,6 +108,10 @@ func resourceSCIGSLBMonitorV1() *schema.Resource {
 	}
 }
 
+func resourceSCIGSLBMonitorV1CustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return errors.Join(andromedaValidateMonitorV1(diff, "")...)
+}
+
 func resourceSCIGSLBMonitorV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
@@ -2,14 +2,20 @@ package sci
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sapcc/andromeda/client"
+	"github.com/sapcc/andromeda/client/members"
+	"github.com/sapcc/andromeda/client/monitors"
 	"github.com/sapcc/andromeda/client/pools"
 	"github.com/sapcc/andromeda/models"
 )
@@ -23,6 +29,7 @@ func resourceSCIGSLBPoolV1() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceSCIGSLBPoolV1CustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"region": {
@@ -51,6 +58,25 @@ func resourceSCIGSLBPoolV1() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"member": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: andromedaPoolMemberSchemaV1(),
+				},
+			},
+			"monitor": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: andromedaPoolMonitorSchemaV1(),
+				},
+			},
+			"authoritative": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 
 			// computed
 			"members": {
@@ -83,6 +109,53 @@ func resourceSCIGSLBPoolV1() *schema.Resource {
 	}
 }
 
+func resourceSCIGSLBPoolV1CustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	var errs []error
+	for i := range diff.Get("monitor").([]interface{}) {
+		errs = append(errs, andromedaValidateMonitorV1(diff, fmt.Sprintf("monitor.%d.", i))...)
+	}
+
+	// validate the address family of the inline members against the domains
+	if !diff.HasChanges("member", "domains") || !diff.NewValueKnown("domains") {
+		return errors.Join(errs...)
+	}
+	var domainIDs []strfmt.UUID
+	for _, v := range diff.Get("domains").([]interface{}) {
+		if v, _ := v.(string); v != "" {
+			domainIDs = append(domainIDs, strfmt.UUID(v))
+		}
+	}
+	if len(domainIDs) == 0 || len(diff.Get("member").([]interface{})) == 0 {
+		return errors.Join(errs...)
+	}
+
+	config := meta.(*Config)
+	c, err := config.andromedaV1Client(ctx, andromedaDiffRegion(diff, config))
+	if err != nil {
+		return fmt.Errorf("error creating Andromeda client: %s", err)
+	}
+
+	recordTypes, err := andromedaGetRecordTypesV1(ctx, c.Domains, domainIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range diff.Get("member").([]interface{}) {
+		key := fmt.Sprintf("member.%d.address", i)
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+		address := diff.Get(key).(string)
+		for _, id := range domainIDs {
+			if v, ok := recordTypes[id]; ok && !andromedaAddressMatchesRecordTypeV1(v, address) {
+				errs = append(errs, fmt.Errorf("%s: the %q address doesn't match the %s record type of the %s domain", key, address, v, id))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func resourceSCIGSLBPoolV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	c, err := config.andromedaV1Client(ctx, GetRegion(d, config))
@@ -136,7 +209,20 @@ func resourceSCIGSLBPoolV1Create(ctx context.Context, d *schema.ResourceData, me
 
 	andromedaSetPoolResource(d, config, pool)
 
-	return nil
+	// create the inline members and monitors
+	inline := andromedaPoolInlineV1{
+		members:  d.Get("member").([]interface{}),
+		monitors: d.Get("monitor").([]interface{}),
+	}
+	if len(inline.members) > 0 || len(inline.monitors) > 0 {
+		projectID := d.Get("project_id").(string)
+		err = andromedaReconcilePoolV1(ctx, c, id, projectID, andromedaPoolInlineV1{}, inline, false, timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSCIGSLBPoolV1Read(ctx, d, meta)
 }
 
 func resourceSCIGSLBPoolV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -159,6 +245,11 @@ func resourceSCIGSLBPoolV1Read(ctx context.Context, d *schema.ResourceData, meta
 
 	andromedaSetPoolResource(d, config, pool)
 
+	err = andromedaReadPoolInlineV1(ctx, c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -190,30 +281,51 @@ func resourceSCIGSLBPoolV1Update(ctx context.Context, d *schema.ResourceData, me
 		pool.ProjectID = &v
 	}
 
-	opts := &pools.PutPoolsPoolIDParams{
-		Pool: pools.PutPoolsPoolIDBody{
-			Pool: pool,
-		},
-		PoolID:  strfmt.UUID(id),
-		Context: ctx,
-	}
-	_, err = client.PutPoolsPoolID(opts)
-	if err != nil {
-		return diag.Errorf("error updating Andromeda pool: %s", err)
-	}
-
-	// waiting for ACTIVE status
 	timeout := d.Timeout(schema.TimeoutUpdate)
-	target := models.PoolProvisioningStatusACTIVE
-	pending := models.PoolProvisioningStatusPENDINGUPDATE
-	pool, err = andromedaWaitForPool(ctx, client, id, target, pending, timeout)
-	if err != nil {
-		return diag.FromErr(err)
+	if d.HasChanges("admin_state_up", "domains", "name", "project_id") {
+		opts := &pools.PutPoolsPoolIDParams{
+			Pool: pools.PutPoolsPoolIDBody{
+				Pool: pool,
+			},
+			PoolID:  strfmt.UUID(id),
+			Context: ctx,
+		}
+		_, err = client.PutPoolsPoolID(opts)
+		if err != nil {
+			return diag.Errorf("error updating Andromeda pool: %s", err)
+		}
+
+		// waiting for ACTIVE status
+		target := models.PoolProvisioningStatusACTIVE
+		pending := models.PoolProvisioningStatusPENDINGUPDATE
+		pool, err = andromedaWaitForPool(ctx, client, id, target, pending, timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		andromedaSetPoolResource(d, config, pool)
 	}
 
-	andromedaSetPoolResource(d, config, pool)
+	if d.HasChanges("member", "monitor", "authoritative") {
+		projectID := d.Get("project_id").(string)
+		authoritative := d.Get("authoritative").(bool)
+		oldMembers, newMembers := d.GetChange("member")
+		oldMonitors, newMonitors := d.GetChange("monitor")
+		old := andromedaPoolInlineV1{
+			members:  oldMembers.([]interface{}),
+			monitors: oldMonitors.([]interface{}),
+		}
+		inline := andromedaPoolInlineV1{
+			members:  newMembers.([]interface{}),
+			monitors: newMonitors.([]interface{}),
+		}
+		err = andromedaReconcilePoolV1(ctx, c, id, projectID, old, inline, authoritative, timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
-	return nil
+	return resourceSCIGSLBPoolV1Read(ctx, d, meta)
 }
 
 func resourceSCIGSLBPoolV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -225,6 +337,21 @@ func resourceSCIGSLBPoolV1Delete(ctx context.Context, d *schema.ResourceData, me
 	client := c.Pools
 
 	id := d.Id()
+	timeout := d.Timeout(schema.TimeoutDelete)
+
+	// delete the inline members and monitors before the pool
+	old := andromedaPoolInlineV1{
+		members:  d.Get("member").([]interface{}),
+		monitors: d.Get("monitor").([]interface{}),
+	}
+	if len(old.members) > 0 || len(old.monitors) > 0 {
+		projectID := d.Get("project_id").(string)
+		err = andromedaReconcilePoolV1(ctx, c, id, projectID, old, andromedaPoolInlineV1{}, false, timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	opts := &pools.DeletePoolsPoolIDParams{
 		PoolID:  strfmt.UUID(id),
 		Context: ctx,
@@ -238,7 +365,6 @@ func resourceSCIGSLBPoolV1Delete(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	// waiting for DELETED status
-	timeout := d.Timeout(schema.TimeoutDelete)
 	target := "DELETED"
 	pending := models.PoolProvisioningStatusPENDINGDELETE
 	_, err = andromedaWaitForPool(ctx, client, id, target, pending, timeout)
@@ -315,3 +441,558 @@ func andromedaSetPoolResource(d *schema.ResourceData, config *Config, pool *mode
 
 	_ = d.Set("region", GetRegion(d, config))
 }
+
+func andromedaPoolMemberSchemaV1() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"address": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"port": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"admin_state_up": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"datacenter_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		// computed
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"provisioning_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func andromedaPoolMonitorSchemaV1() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"admin_state_up": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"interval": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"domain_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"receive": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"send": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"timeout": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"type": {
+			Type: schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{
+				"ICMP", "HTTP", "HTTPS", "TCP", "UDP",
+			}, false),
+			Optional: true,
+			Default:  "ICMP",
+		},
+		"http_method": {
+			Type: schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{
+				"GET", "POST", "PUT", "HEAD", "DELETE", "OPTIONS",
+			}, false),
+			Optional: true,
+			Default:  "GET",
+		},
+
+		// computed
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"provisioning_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// andromedaPoolInlineV1 holds the raw inline member and monitor blocks of the
+// pool.
+type andromedaPoolInlineV1 struct {
+	members  []interface{}
+	monitors []interface{}
+}
+
+// andromedaPoolMemberKey returns the key, which identifies the inline member.
+func andromedaPoolMemberKey(address string, port int64) string {
+	return fmt.Sprintf("%s:%d", address, port)
+}
+
+// andromedaInlineIDs returns the IDs of the inline blocks.
+func andromedaInlineIDs(raw []interface{}) map[strfmt.UUID]bool {
+	res := make(map[strfmt.UUID]bool, len(raw))
+	for _, v := range raw {
+		if v, ok := v.(map[string]interface{}); ok && v["id"] != "" {
+			res[strfmt.UUID(v["id"].(string))] = true
+		}
+	}
+	return res
+}
+
+func andromedaExpandPoolMemberV1(v map[string]interface{}, poolID, projectID string) *models.Member {
+	member := &models.Member{
+		Address:      ptr(v["address"].(string)),
+		AdminStateUp: ptr(v["admin_state_up"].(bool)),
+		Name:         ptr(v["name"].(string)),
+		PoolID:       ptr(strfmt.UUID(poolID)),
+		Port:         ptr(int64(v["port"].(int))),
+	}
+	if v := v["datacenter_id"].(string); v != "" {
+		member.DatacenterID = ptr(strfmt.UUID(v))
+	}
+	if projectID != "" {
+		member.ProjectID = ptr(projectID)
+	}
+	return member
+}
+
+func andromedaExpandPoolMonitorV1(v map[string]interface{}, poolID, projectID string) *models.Monitor {
+	monitor := &models.Monitor{
+		AdminStateUp: ptr(v["admin_state_up"].(bool)),
+		HTTPMethod:   ptr(v["http_method"].(string)),
+		Name:         ptr(v["name"].(string)),
+		PoolID:       ptr(strfmt.UUID(poolID)),
+		Receive:      ptr(v["receive"].(string)),
+		Send:         ptr(v["send"].(string)),
+		Type:         ptr(v["type"].(string)),
+	}
+	if v := v["interval"].(int); v != 0 {
+		monitor.Interval = ptr(int64(v))
+	}
+	if v := v["timeout"].(int); v != 0 {
+		monitor.Timeout = ptr(int64(v))
+	}
+	if v := v["domain_name"].(string); v != "" {
+		monitor.DomainName = ptr(strfmt.Hostname(v))
+	}
+	if projectID != "" {
+		monitor.ProjectID = ptr(projectID)
+	}
+	return monitor
+}
+
+func andromedaFlattenPoolMemberV1(member *models.Member) map[string]interface{} {
+	return map[string]interface{}{
+		"address":             ptrValue(member.Address),
+		"admin_state_up":      ptrValue(member.AdminStateUp),
+		"datacenter_id":       ptrValue(member.DatacenterID).String(),
+		"name":                ptrValue(member.Name),
+		"port":                ptrValue(member.Port),
+		"id":                  string(member.ID),
+		"provisioning_status": member.ProvisioningStatus,
+		"status":              member.Status,
+	}
+}
+
+func andromedaFlattenPoolMonitorV1(monitor *models.Monitor) map[string]interface{} {
+	return map[string]interface{}{
+		"name":                ptrValue(monitor.Name),
+		"admin_state_up":      ptrValue(monitor.AdminStateUp),
+		"interval":            ptrValue(monitor.Interval),
+		"domain_name":         ptrValue(monitor.DomainName).String(),
+		"receive":             ptrValue(monitor.Receive),
+		"send":                ptrValue(monitor.Send),
+		"timeout":             ptrValue(monitor.Timeout),
+		"type":                ptrValue(monitor.Type),
+		"http_method":         ptrValue(monitor.HTTPMethod),
+		"id":                  string(monitor.ID),
+		"provisioning_status": monitor.ProvisioningStatus,
+	}
+}
+
+// andromedaPoolMemberChangedV1 returns true, when the live member differs from
+// the inline member.
+func andromedaPoolMemberChangedV1(live, member *models.Member) bool {
+	return ptrValue(live.AdminStateUp) != ptrValue(member.AdminStateUp) ||
+		ptrValue(live.DatacenterID) != ptrValue(member.DatacenterID) ||
+		ptrValue(live.Name) != ptrValue(member.Name)
+}
+
+// andromedaPoolMonitorChangedV1 returns true, when the live monitor differs
+// from the inline monitor.
+func andromedaPoolMonitorChangedV1(live, monitor *models.Monitor) bool {
+	return ptrValue(live.AdminStateUp) != ptrValue(monitor.AdminStateUp) ||
+		ptrValue(live.DomainName) != ptrValue(monitor.DomainName) ||
+		ptrValue(live.HTTPMethod) != ptrValue(monitor.HTTPMethod) ||
+		ptrValue(live.Interval) != ptrValue(monitor.Interval) ||
+		ptrValue(live.Receive) != ptrValue(monitor.Receive) ||
+		ptrValue(live.Send) != ptrValue(monitor.Send) ||
+		(monitor.Timeout != nil && ptrValue(live.Timeout) != ptrValue(monitor.Timeout)) ||
+		ptrValue(live.Type) != ptrValue(monitor.Type)
+}
+
+// andromedaReconcilePoolV1 creates, updates and deletes the live pool members
+// and monitors to match the inline blocks. The members are identified by the
+// address and the port, the monitors by the name. Live members and monitors,
+// which are not in the old inline blocks, e.g. managed by the standalone
+// resources, are deleted only when authoritative. All changes are applied
+// first, followed by a single wait for the provisioning.
+func andromedaReconcilePoolV1(ctx context.Context, c *client.Andromeda, poolID, projectID string, old, inline andromedaPoolInlineV1, authoritative bool, timeout time.Duration) error {
+	pending := make(map[strfmt.UUID]string)
+
+	// members
+	liveMembers, err := andromedaListMembers(c.Members, &members.GetMembersParams{
+		PoolID:  ptr(strfmt.UUID(poolID)),
+		Context: ctx,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing Andromeda pool members: %s", err)
+	}
+
+	matched := make(map[strfmt.UUID]bool)
+	for _, v := range inline.members {
+		member := andromedaExpandPoolMemberV1(v.(map[string]interface{}), poolID, projectID)
+		key := andromedaPoolMemberKey(ptrValue(member.Address), ptrValue(member.Port))
+
+		var live *models.Member
+		for _, m := range liveMembers {
+			if !matched[m.ID] && andromedaPoolMemberKey(ptrValue(m.Address), ptrValue(m.Port)) == key {
+				live = m
+				break
+			}
+		}
+
+		if live == nil {
+			res, err := c.Members.PostMembers(&members.PostMembersParams{
+				Member:  members.PostMembersBody{Member: member},
+				Context: ctx,
+			})
+			if err != nil {
+				return fmt.Errorf("error creating Andromeda pool member %s: %s", key, err)
+			}
+			if res == nil || res.Payload == nil || res.Payload.Member == nil {
+				return fmt.Errorf("error creating Andromeda pool member %s: empty response", key)
+			}
+			log.Printf("[DEBUG] Created Andromeda pool member: %v", res)
+			pending[res.Payload.Member.ID] = models.MemberProvisioningStatusACTIVE
+			continue
+		}
+
+		matched[live.ID] = true
+		if !andromedaPoolMemberChangedV1(live, member) {
+			continue
+		}
+		member.PoolID = nil
+		member.ProjectID = nil
+		_, err = c.Members.PutMembersMemberID(&members.PutMembersMemberIDParams{
+			Member:   members.PutMembersMemberIDBody{Member: member},
+			MemberID: live.ID,
+			Context:  ctx,
+		})
+		if err != nil {
+			return fmt.Errorf("error updating Andromeda pool member %s: %s", key, err)
+		}
+		pending[live.ID] = models.MemberProvisioningStatusACTIVE
+	}
+
+	managed := andromedaInlineIDs(old.members)
+	for _, m := range liveMembers {
+		if matched[m.ID] || !(authoritative || managed[m.ID]) {
+			continue
+		}
+		_, err = c.Members.DeleteMembersMemberID(&members.DeleteMembersMemberIDParams{
+			MemberID: m.ID,
+			Context:  ctx,
+		})
+		if err != nil {
+			if _, ok := err.(*members.DeleteMembersMemberIDNotFound); ok {
+				continue
+			}
+			return fmt.Errorf("error deleting Andromeda pool member %s: %s", m.ID, err)
+		}
+		pending[m.ID] = "DELETED"
+	}
+
+	// monitors
+	liveMonitors, err := andromedaListMonitors(c.Monitors, &monitors.GetMonitorsParams{
+		PoolID:  ptr(strfmt.UUID(poolID)),
+		Context: ctx,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing Andromeda pool monitors: %s", err)
+	}
+
+	matched = make(map[strfmt.UUID]bool)
+	for _, v := range inline.monitors {
+		monitor := andromedaExpandPoolMonitorV1(v.(map[string]interface{}), poolID, projectID)
+		name := ptrValue(monitor.Name)
+
+		var live *models.Monitor
+		for _, m := range liveMonitors {
+			if !matched[m.ID] && ptrValue(m.Name) == name {
+				live = m
+				break
+			}
+		}
+
+		if live == nil {
+			res, err := c.Monitors.PostMonitors(&monitors.PostMonitorsParams{
+				Monitor: monitors.PostMonitorsBody{Monitor: monitor},
+				Context: ctx,
+			})
+			if err != nil {
+				return fmt.Errorf("error creating Andromeda pool monitor %s: %s", name, err)
+			}
+			if res == nil || res.Payload == nil || res.Payload.Monitor == nil {
+				return fmt.Errorf("error creating Andromeda pool monitor %s: empty response", name)
+			}
+			log.Printf("[DEBUG] Created Andromeda pool monitor: %v", res)
+			pending[res.Payload.Monitor.ID] = models.MonitorProvisioningStatusACTIVE
+			continue
+		}
+
+		matched[live.ID] = true
+		if !andromedaPoolMonitorChangedV1(live, monitor) {
+			continue
+		}
+		monitor.PoolID = nil
+		monitor.ProjectID = nil
+		_, err = c.Monitors.PutMonitorsMonitorID(&monitors.PutMonitorsMonitorIDParams{
+			Monitor:   monitors.PutMonitorsMonitorIDBody{Monitor: monitor},
+			MonitorID: live.ID,
+			Context:   ctx,
+		})
+		if err != nil {
+			return fmt.Errorf("error updating Andromeda pool monitor %s: %s", name, err)
+		}
+		pending[live.ID] = models.MonitorProvisioningStatusACTIVE
+	}
+
+	managed = andromedaInlineIDs(old.monitors)
+	for _, m := range liveMonitors {
+		if matched[m.ID] || !(authoritative || managed[m.ID]) {
+			continue
+		}
+		_, err = c.Monitors.DeleteMonitorsMonitorID(&monitors.DeleteMonitorsMonitorIDParams{
+			MonitorID: m.ID,
+			Context:   ctx,
+		})
+		if err != nil {
+			if _, ok := err.(*monitors.DeleteMonitorsMonitorIDNotFound); ok {
+				continue
+			}
+			return fmt.Errorf("error deleting Andromeda pool monitor %s: %s", m.ID, err)
+		}
+		pending[m.ID] = "DELETED"
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	return andromedaWaitForPoolInlineV1(ctx, c, poolID, pending, timeout)
+}
+
+// andromedaWaitForPoolInlineV1 waits for the modified pool members and
+// monitors to reach the target provisioning status.
+func andromedaWaitForPoolInlineV1(ctx context.Context, c *client.Andromeda, poolID string, pending map[strfmt.UUID]string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for %d members and monitors of the %s pool to become ACTIVE.", len(pending), poolID)
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{models.PoolProvisioningStatusACTIVE},
+		Pending:    []string{models.PoolProvisioningStatusPENDINGUPDATE},
+		Refresh:    andromedaGetPoolInlineStatusV1(ctx, c, poolID, pending),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for the members and monitors of the %s pool to become ACTIVE: %s", poolID, err)
+	}
+
+	return nil
+}
+
+func andromedaGetPoolInlineStatusV1(ctx context.Context, c *client.Andromeda, poolID string, pending map[strfmt.UUID]string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		liveMembers, err := andromedaListMembers(c.Members, &members.GetMembersParams{
+			PoolID:  ptr(strfmt.UUID(poolID)),
+			Context: ctx,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		liveMonitors, err := andromedaListMonitors(c.Monitors, &monitors.GetMonitorsParams{
+			PoolID:  ptr(strfmt.UUID(poolID)),
+			Context: ctx,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := make(map[strfmt.UUID]string, len(liveMembers)+len(liveMonitors))
+		for _, m := range liveMembers {
+			status[m.ID] = m.ProvisioningStatus
+		}
+		for _, m := range liveMonitors {
+			status[m.ID] = m.ProvisioningStatus
+		}
+
+		for id, target := range pending {
+			v, ok := status[id]
+			switch {
+			case !ok && target == "DELETED":
+				continue
+			case !ok:
+				return nil, "", fmt.Errorf("%s is not found", id)
+			case v == models.MemberProvisioningStatusERROR:
+				return nil, "", fmt.Errorf("%s has the %s provisioning status", id, v)
+			case v != target:
+				return pending, models.PoolProvisioningStatusPENDINGUPDATE, nil
+			}
+		}
+
+		return pending, models.PoolProvisioningStatusACTIVE, nil
+	}
+}
+
+// andromedaReadPoolInlineV1 refreshes the inline member and monitor blocks.
+// The blocks keep their order, live members and monitors, which are not in
+// the blocks, are appended only when authoritative.
+func andromedaReadPoolInlineV1(ctx context.Context, c *client.Andromeda, d *schema.ResourceData) error {
+	authoritative := d.Get("authoritative").(bool)
+	inline := andromedaPoolInlineV1{
+		members:  d.Get("member").([]interface{}),
+		monitors: d.Get("monitor").([]interface{}),
+	}
+	if !authoritative && len(inline.members) == 0 && len(inline.monitors) == 0 {
+		return nil
+	}
+
+	liveMembers, err := andromedaListMembers(c.Members, &members.GetMembersParams{
+		PoolID:  ptr(strfmt.UUID(d.Id())),
+		Context: ctx,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing Andromeda pool members: %s", err)
+	}
+	liveMonitors, err := andromedaListMonitors(c.Monitors, &monitors.GetMonitorsParams{
+		PoolID:  ptr(strfmt.UUID(d.Id())),
+		Context: ctx,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing Andromeda pool monitors: %s", err)
+	}
+
+	// the blocks created by the last apply don't have an ID yet and the blocks
+	// with a changed address or port keep the ID of the replaced member,
+	// therefore they are matched by the address and the port, like in
+	// andromedaReconcilePoolV1
+	ids := andromedaInlineIDs(inline.members)
+	var memberList []map[string]interface{}
+	for _, v := range inline.members {
+		v := v.(map[string]interface{})
+		id := strfmt.UUID(v["id"].(string))
+		key := andromedaPoolMemberKey(v["address"].(string), int64(v["port"].(int)))
+
+		var live *models.Member
+		for _, m := range liveMembers {
+			if id != "" && m.ID == id {
+				live = m
+				break
+			}
+		}
+		for _, m := range liveMembers {
+			if live == nil && !ids[m.ID] && andromedaPoolMemberKey(ptrValue(m.Address), ptrValue(m.Port)) == key {
+				live = m
+				ids[m.ID] = true
+			}
+		}
+
+		if live != nil {
+			memberList = append(memberList, andromedaFlattenPoolMemberV1(live))
+		}
+	}
+	if authoritative {
+		sort.SliceStable(liveMembers, func(i, j int) bool {
+			return andromedaPoolMemberKey(ptrValue(liveMembers[i].Address), ptrValue(liveMembers[i].Port)) <
+				andromedaPoolMemberKey(ptrValue(liveMembers[j].Address), ptrValue(liveMembers[j].Port))
+		})
+		for _, m := range liveMembers {
+			if !ids[m.ID] {
+				memberList = append(memberList, andromedaFlattenPoolMemberV1(m))
+			}
+		}
+	}
+
+	ids = andromedaInlineIDs(inline.monitors)
+	var monitorList []map[string]interface{}
+	for _, v := range inline.monitors {
+		v := v.(map[string]interface{})
+		id := strfmt.UUID(v["id"].(string))
+		name := v["name"].(string)
+
+		var live *models.Monitor
+		for _, m := range liveMonitors {
+			if id != "" && m.ID == id {
+				live = m
+				break
+			}
+		}
+		for _, m := range liveMonitors {
+			if live == nil && !ids[m.ID] && ptrValue(m.Name) == name {
+				live = m
+				ids[m.ID] = true
+			}
+		}
+
+		if live != nil {
+			monitorList = append(monitorList, andromedaFlattenPoolMonitorV1(live))
+		}
+	}
+	if authoritative {
+		sort.SliceStable(liveMonitors, func(i, j int) bool {
+			return ptrValue(liveMonitors[i].Name) < ptrValue(liveMonitors[j].Name)
+		})
+		for _, m := range liveMonitors {
+			if !ids[m.ID] {
+				monitorList = append(monitorList, andromedaFlattenPoolMonitorV1(m))
+			}
+		}
+	}
+
+	_ = d.Set("member", memberList)
+	_ = d.Set("monitor", monitorList)
+
+	return nil
+}
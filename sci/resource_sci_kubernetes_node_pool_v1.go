@@ -0,0 +1,373 @@
+package sci
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sapcc/kubernikus/pkg/api/client/operations"
+	"github.com/sapcc/kubernikus/pkg/api/models"
+)
+
+var kubernikusNodePoolV1Fields = []string{
+	"name",
+	"flavor",
+	"image",
+	"size",
+	"availability_zone",
+	"taint",
+	"labels",
+	"custom_root_disk_size",
+	"config",
+}
+
+func resourceSCIKubernetesNodePoolV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSCIKubernetesNodePoolV1Read,
+		UpdateContext: resourceSCIKubernetesNodePoolV1Update,
+		CreateContext: resourceSCIKubernetesNodePoolV1Create,
+		DeleteContext: resourceSCIKubernetesNodePoolV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSCIKubernetesNodePoolV1Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: kubernikusValidateClusterName,
+			},
+
+			"is_admin": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: kubernikusValidatePoolName,
+			},
+
+			"flavor": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"image": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 127),
+			},
+
+			"availability_zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"taint": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     kubernikusNodePoolTaintSchemaV1(),
+			},
+
+			"labels": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: kubernikusValidateLabels,
+			},
+
+			"custom_root_disk_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(64, 1024),
+			},
+
+			"config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_reboot": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"allow_replace": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSCIKubernetesNodePoolV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	clusterName := d.Get("cluster_name").(string)
+	pool := kubernikusExpandNodePoolV1FromResourceData(d)
+	log.Printf("[KUBERNETES] Creating Kubernikus node pool %s/%s in project %s", clusterName, pool.Name, config.TenantID)
+
+	klient, err := config.kubernikusV1Client(ctx, region, d.Get("is_admin").(bool))
+	if err != nil {
+		return diag.Errorf("Error creating Kubernikus client: %s", err)
+	}
+
+	// report the warning events of the operation
+	defer func() { diags = append(diags, klient.events.diagnostics()...) }()
+
+	err = kubernikusModifyNodePoolsV1(config, klient, region, clusterName, func(nodePools []models.NodePool) ([]models.NodePool, error) {
+		if _, p := kubernikusFindNodePoolV1(nodePools, pool.Name); p != nil {
+			return nil, fmt.Errorf("node pool %q already exists in %q cluster", pool.Name, clusterName)
+		}
+		return append(nodePools, pool), nil
+	})
+	if err != nil {
+		return diag.FromErr(kubernikusHandleErrorV1("Error creating node pool", err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", clusterName, pool.Name))
+
+	err = kubernikusWaitForNodePoolV1(ctx, klient, clusterName, pool.Name, "Running", d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(kubernikusHandleErrorV1("Error waiting for node pool Running state", err))
+	}
+
+	return resourceSCIKubernetesNodePoolV1Read(ctx, d, meta)
+}
+
+func resourceSCIKubernetesNodePoolV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	log.Printf("[KUBERNETES] Reading Kubernikus node pool %s in project %s", d.Id(), config.TenantID)
+
+	clusterName, poolName, err := parsePairedIDs(d.Id(), "sci_kubernetes_node_pool_v1")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	klient, err := config.kubernikusV1Client(ctx, GetRegion(d, config), d.Get("is_admin").(bool))
+	if err != nil {
+		return diag.Errorf("Error creating Kubernikus client: %s", err)
+	}
+
+	result, err := klient.ShowCluster(operations.NewShowClusterParams().WithName(clusterName), klient.authFunc())
+	if err != nil {
+		if kubernikusIsNotFoundV1(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(kubernikusHandleErrorV1("Error reading Kubernikus cluster", err))
+	}
+
+	_, pool := kubernikusFindNodePoolV1(result.Payload.Spec.NodePools, poolName)
+	if pool == nil {
+		log.Printf("[DEBUG] Kubernikus node pool %s not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	for k, v := range kubernikusFlattenNodePoolsV1([]models.NodePool{*pool})[0] {
+		_ = d.Set(k, v)
+	}
+	_ = d.Set("cluster_name", clusterName)
+	_ = d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSCIKubernetesNodePoolV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	clusterName := d.Get("cluster_name").(string)
+	pool := kubernikusExpandNodePoolV1FromResourceData(d)
+	log.Printf("[KUBERNETES] Updating Kubernikus node pool %s/%s in project %s", clusterName, pool.Name, config.TenantID)
+
+	klient, err := config.kubernikusV1Client(ctx, region, d.Get("is_admin").(bool))
+	if err != nil {
+		return diag.Errorf("Error creating Kubernikus client: %s", err)
+	}
+
+	// report the warning events of the operation
+	defer func() { diags = append(diags, klient.events.diagnostics()...) }()
+
+	err = kubernikusModifyNodePoolsV1(config, klient, region, clusterName, func(nodePools []models.NodePool) ([]models.NodePool, error) {
+		i, p := kubernikusFindNodePoolV1(nodePools, pool.Name)
+		if p == nil {
+			return nil, fmt.Errorf("node pool %q not found in %q cluster", pool.Name, clusterName)
+		}
+		// copy previously "computed" AZ
+		if pool.AvailabilityZone == "" {
+			pool.AvailabilityZone = p.AvailabilityZone
+		}
+		nodePools[i] = pool
+		return nodePools, nil
+	})
+	if err != nil {
+		return diag.FromErr(kubernikusHandleErrorV1("Error updating node pool", err))
+	}
+
+	err = kubernikusWaitForNodePoolV1(ctx, klient, clusterName, pool.Name, "Running", d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(kubernikusHandleErrorV1("Error waiting for node pool Running state", err))
+	}
+
+	return resourceSCIKubernetesNodePoolV1Read(ctx, d, meta)
+}
+
+func resourceSCIKubernetesNodePoolV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	clusterName := d.Get("cluster_name").(string)
+	poolName := d.Get("name").(string)
+	log.Printf("[KUBERNETES] Deleting Kubernikus node pool %s/%s in project %s", clusterName, poolName, config.TenantID)
+
+	klient, err := config.kubernikusV1Client(ctx, region, d.Get("is_admin").(bool))
+	if err != nil {
+		return diag.Errorf("Error creating Kubernikus client: %s", err)
+	}
+
+	// report the warning events of the operation
+	defer func() { diags = append(diags, klient.events.diagnostics()...) }()
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+
+	// downscale
+	var found bool
+	err = kubernikusModifyNodePoolsV1(config, klient, region, clusterName, func(nodePools []models.NodePool) ([]models.NodePool, error) {
+		if _, p := kubernikusFindNodePoolV1(nodePools, poolName); p != nil {
+			p.Size = 0
+			found = true
+		}
+		return nodePools, nil
+	})
+	if err != nil {
+		if kubernikusIsNotFoundV1(err) {
+			return nil
+		}
+		return diag.FromErr(kubernikusHandleErrorV1("Error downscaling node pool", err))
+	}
+	if !found {
+		return nil
+	}
+
+	err = kubernikusWaitForNodePoolV1(ctx, klient, clusterName, poolName, "Running", timeout)
+	if err != nil {
+		return diag.FromErr(kubernikusHandleErrorV1("Error waiting for node pool to be downscaled", err))
+	}
+
+	// delete
+	err = kubernikusModifyNodePoolsV1(config, klient, region, clusterName, func(nodePools []models.NodePool) ([]models.NodePool, error) {
+		if i, p := kubernikusFindNodePoolV1(nodePools, poolName); p != nil {
+			nodePools = append(nodePools[:i], nodePools[i+1:]...)
+		}
+		return nodePools, nil
+	})
+	if err != nil {
+		return diag.FromErr(kubernikusHandleErrorV1("Error deleting node pool", err))
+	}
+
+	err = kubernikusWaitForNodePoolV1(ctx, klient, clusterName, poolName, "Deleted", timeout)
+	if err != nil {
+		return diag.FromErr(kubernikusHandleErrorV1("Error waiting for node pool to be deleted", err))
+	}
+
+	return nil
+}
+
+func resourceSCIKubernetesNodePoolV1Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format specified for Kubernetes node pool, format must be <cluster>/<pool>[/<is_admin>]")
+	}
+
+	var isAdmin bool
+	var err error
+	if len(parts) == 3 {
+		isAdmin, err = strconv.ParseBool(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse is_admin field: %s", err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", parts[0], parts[1]))
+	_ = d.Set("cluster_name", parts[0])
+	_ = d.Set("name", parts[1])
+	_ = d.Set("is_admin", isAdmin)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func kubernikusExpandNodePoolV1FromResourceData(d *schema.ResourceData) models.NodePool {
+	v := make(map[string]interface{}, len(kubernikusNodePoolV1Fields))
+	for _, k := range kubernikusNodePoolV1Fields {
+		v[k] = d.Get(k)
+	}
+	return kubernikusExpandNodePoolV1(v)
+}
+
+// kubernikusModifyNodePoolsV1 performs a read-modify-write of the cluster
+// node pools under the per-cluster lock.
+func kubernikusModifyNodePoolsV1(config *Config, klient *kubernikus, region, name string, modify func([]models.NodePool) ([]models.NodePool, error)) error {
+	key := kubernikusClusterMutexKey(region, name)
+	config.MutexKV.Lock(key)
+	defer config.MutexKV.Unlock(key)
+
+	result, err := klient.ShowCluster(operations.NewShowClusterParams().WithName(name), klient.authFunc())
+	if err != nil {
+		return err
+	}
+
+	cluster := result.Payload
+	cluster.Spec.NodePools, err = modify(cluster.Spec.NodePools)
+	if err != nil {
+		return err
+	}
+
+	_, err = klient.UpdateCluster(operations.NewUpdateClusterParams().WithName(name).WithBody(cluster), klient.authFunc())
+
+	return err
+}
@@ -2,12 +2,14 @@ package sci
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,7 +19,14 @@ import (
 
 func resourceSCIKubernetesV1() *schema.Resource {
 	return &schema.Resource{
-		SchemaVersion: 1,
+		SchemaVersion: 2,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceSCIKubernetesV1V1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceSCIKubernetesV1StateUpgradeV1,
+				Version: 1,
+			},
+		},
 
 		ReadContext:   resourceSCIKubernetesV1Read,
 		UpdateContext: resourceSCIKubernetesV1Update,
@@ -27,6 +36,8 @@ func resourceSCIKubernetesV1() *schema.Resource {
 			StateContext: resourceSCIKubernetesV1Import,
 		},
 
+		CustomizeDiff: resourceSCIKubernetesV1CustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -169,6 +180,43 @@ func resourceSCIKubernetesV1() *schema.Resource {
 				ValidateFunc: validateKubernetesVersion,
 			},
 
+			"wait_for_apiserver": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"wait_for_ready_nodes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"on_destroy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  kubernikusOnDestroyDelete,
+				ValidateFunc: validation.StringInSlice([]string{
+					kubernikusOnDestroyDelete, kubernikusOnDestroyOrphan,
+				}, false),
+			},
+
+			"upgrade_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  kubernikusUpgradeDirect,
+				ValidateFunc: validation.StringInSlice([]string{
+					kubernikusUpgradeDirect, kubernikusUpgradeStepwise,
+				}, false),
+			},
+
 			"node_pools": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -191,9 +239,25 @@ func resourceSCIKubernetesV1() *schema.Resource {
 							ValidateFunc: validation.NoZeroValues,
 						},
 						"size": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          0,
+							ValidateFunc:     validation.IntBetween(0, 127),
+							DiffSuppressFunc: kubernikusSuppressExternalSizeDiffV1,
+						},
+						"size_managed_externally": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"min_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 127),
+						},
+						"max_size": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      0,
 							ValidateFunc: validation.IntBetween(0, 127),
 						},
 						"availability_zone": {
@@ -202,21 +266,27 @@ func resourceSCIKubernetesV1() *schema.Resource {
 							Computed:     true,
 							ValidateFunc: validation.NoZeroValues,
 						},
-						"taints": {
+						"taint": {
 							Type:     schema.TypeList,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Elem:     kubernikusNodePoolTaintSchemaV1(),
 						},
 						"labels": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ValidateFunc: kubernikusValidateLabels,
 						},
 						"custom_root_disk_size": {
 							Type:         schema.TypeInt,
 							Optional:     true,
 							ValidateFunc: validation.IntBetween(64, 1024),
 						},
+						"wait_for_healthy": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
 						"config": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -234,6 +304,14 @@ func resourceSCIKubernetesV1() *schema.Resource {
 										Optional: true,
 										Computed: true,
 									},
+									"rollover_strategy": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  kubernikusRolloverRecreate,
+										ValidateFunc: validation.StringInSlice([]string{
+											kubernikusRolloverRecreate, kubernikusRolloverBlueGreen,
+										}, false),
+									},
 								},
 							},
 						},
@@ -291,6 +369,14 @@ func resourceSCIKubernetesV1() *schema.Resource {
 				Computed: true,
 			},
 
+			"node_pool_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: kubernikusNodePoolStatusSchemaV1(),
+				},
+			},
+
 			"wormhole": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -306,6 +392,39 @@ func resourceSCIKubernetesV1() *schema.Resource {
 				Computed: true,
 			},
 
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"first_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"kube_config": {
 				Type:      schema.TypeList,
 				Computed:  true,
@@ -350,11 +469,79 @@ func resourceSCIKubernetesV1() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+
+			"kube_config_renew_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "0s",
+				ValidateFunc: validateTimeout,
+			},
+
+			"kube_config_expires_in": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
 
-func resourceSCIKubernetesV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceSCIKubernetesV1CustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := kubernikusRenewKubeConfigDiffV1(diff); err != nil {
+		return err
+	}
+
+	if err := kubernikusValidateDeletionProtectionV1(diff, kubernikusClusterForceNewKeysV1()); err != nil {
+		return err
+	}
+
+	errs := kubernikusValidateNodePoolNamesV1(diff)
+	errs = append(errs, kubernikusValidateNodePoolsSizeV1(diff)...)
+
+	versionChanged := diff.HasChange("version") && diff.NewValueKnown("version") && diff.Get("version").(string) != ""
+	poolsChanged := diff.HasChange("node_pools")
+	if !versionChanged && !poolsChanged {
+		return errors.Join(errs...)
+	}
+
+	config := meta.(*Config)
+	region := config.Region
+	if v, ok := diff.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	klient, err := config.kubernikusV1Client(ctx, region, diff.Get("is_admin").(bool))
+	if err != nil {
+		return fmt.Errorf("Error creating Kubernikus client: %s", err)
+	}
+
+	if versionChanged {
+		o, n := diff.GetChange("version")
+		stepwise := diff.Get("upgrade_strategy").(string) == kubernikusUpgradeStepwise
+		if err := kubernikusValidateVersionChangeV1(klient, o.(string), n.(string), stepwise); err != nil {
+			errs = append(errs, fmt.Errorf("version: %s", err))
+		}
+	}
+
+	if poolsChanged {
+		res, err := klient.GetOpenstackMetadata(operations.NewGetOpenstackMetadataParams(), klient.authFunc())
+		if err != nil {
+			return fmt.Errorf("Error fetching Kubernikus OpenStack metadata: %s", err)
+		}
+
+		var imgs []images.Image
+		if kubernikusNodePoolImagesChangedV1(diff) {
+			imgs, err = kubernikusListImagesV1(ctx, config, region)
+			if err != nil {
+				return err
+			}
+		}
+		errs = append(errs, kubernikusValidateNodePoolsMetadataV1(diff, res.Payload, imgs)...)
+	}
+
+	return errors.Join(errs...)
+}
+
+func resourceSCIKubernetesV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	config := meta.(*Config)
 	log.Printf("[KUBERNETES] Creating Kubernikus Kluster in project %s", config.TenantID)
 
@@ -363,6 +550,11 @@ func resourceSCIKubernetesV1Create(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error creating Kubernikus client: %s", err)
 	}
 
+	// report the warning events of the operation
+	defer func() { diags = append(diags, klient.events.diagnostics()...) }()
+
+	klient.skipHealthy = kubernikusExpandNodePoolsSkipHealthyV1(d.Get("node_pools"))
+
 	cluster := &models.Kluster{
 		Spec: models.KlusterSpec{
 			NodePools: []models.NodePool{},
@@ -431,6 +623,21 @@ func resourceSCIKubernetesV1Create(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(kubernikusHandleErrorV1("Error waiting for running cluster state", err))
 	}
 
+	readyNodes := d.Get("wait_for_ready_nodes").(int)
+	if d.Get("wait_for_apiserver").(bool) || readyNodes > 0 {
+		kubeConfigRaw, _, err := getCredentials(klient, cluster.Name, "", 0)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// reuse the downloaded credentials in the Read
+		_ = d.Set("kube_config_raw", kubeConfigRaw)
+
+		err = kubernikusWaitForAPIServerV1(ctx, cluster.Name, kubeConfigRaw, readyNodes, timeout)
+		if err != nil {
+			return diag.Errorf("Error waiting for the Kubernikus cluster API server to become ready: %s", err)
+		}
+	}
+
 	return resourceSCIKubernetesV1Read(ctx, d, meta)
 }
 
@@ -479,24 +686,46 @@ func resourceSCIKubernetesV1Read(ctx context.Context, d *schema.ResourceData, me
 	_ = d.Set("apiserver_url", result.Payload.Status.Apiserver)
 	_ = d.Set("dashboard_url", result.Payload.Status.Dashboard)
 	_ = d.Set("openstack", kubernikusFlattenOpenstackSpecV1(&result.Payload.Spec.Openstack))
-	_ = d.Set("node_pools", kubernikusFlattenNodePoolsV1(result.Payload.Spec.NodePools))
+	// the node pools, which are not in the state, are managed by the
+	// sci_kubernetes_node_pool_v1 resources
+	managed := kubernikusNodePoolNamesV1(d.Get("node_pools"))
+	var owned []models.NodePool
+	for _, p := range result.Payload.Spec.NodePools {
+		if managed[p.Name] {
+			owned = append(owned, p)
+		}
+	}
+	nodePools := kubernikusFlattenNodePoolsV1(owned)
+	kubernikusKeepNodePoolsOptionsV1(d, nodePools)
+	_ = d.Set("node_pools", nodePools)
+	_ = d.Set("node_pool_status", kubernikusFlattenNodePoolsStatusV1(result.Payload.Status.NodePools))
+
+	events, err := klient.GetClusterEvents(operations.NewGetClusterEventsParams().WithName(d.Id()), klient.authFunc())
+	if err != nil {
+		return diag.Errorf("Error reading Kubernikus cluster events: %s", err)
+	}
+	_ = d.Set("events", kubernikusFlattenEventsV1(events.Payload))
 
 	_ = d.Set("region", GetRegion(d, config))
 
 	// if cluster is in pending state, than there are no credentials yet
 	if result.Payload.Status.Phase != models.KlusterPhasePending {
-		kubeConfigRaw, kubeConfig, err := getCredentials(klient, d.Id(), d.Get("kube_config_raw").(string))
+		renewBefore, _ := time.ParseDuration(d.Get("kube_config_renew_before").(string))
+		kubeConfigRaw, kubeConfig, err := getCredentials(klient, d.Id(), d.Get("kube_config_raw").(string), renewBefore)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 		_ = d.Set("kube_config", kubeConfig)
 		_ = d.Set("kube_config_raw", kubeConfigRaw)
+		_ = d.Set("kube_config_expires_in", kubernikusKubeConfigExpiresInV1(kubeConfig))
+
+		return kubernikusRenewBeforeDiagnosticsV1(kubeConfig, renewBefore)
 	}
 
 	return nil
 }
 
-func resourceSCIKubernetesV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceSCIKubernetesV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	config := meta.(*Config)
 	log.Printf("[KUBERNETES] Updating Kubernikus Kluster in project %s", config.TenantID)
 
@@ -505,6 +734,20 @@ func resourceSCIKubernetesV1Update(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error creating Kubernikus client: %s", err)
 	}
 
+	// report the warning events of the operation
+	defer func() { diags = append(diags, klient.events.diagnostics()...) }()
+
+	klient.skipHealthy = kubernikusExpandNodePoolsSkipHealthyV1(d.Get("node_pools"))
+
+	// the credentials are rotated by the Read, the provider side settings are
+	// written to the state only
+	if !d.HasChangesExcept(
+		"kube_config", "kube_config_raw", "kube_config_expires_in", "kube_config_renew_before",
+		"deletion_protection", "on_destroy", "upgrade_strategy", "wait_for_apiserver", "wait_for_ready_nodes",
+	) {
+		return resourceSCIKubernetesV1Read(ctx, d, meta)
+	}
+
 	timeout := d.Timeout(schema.TimeoutUpdate)
 	cluster := &models.Kluster{
 		Spec: models.KlusterSpec{
@@ -552,7 +795,14 @@ func resourceSCIKubernetesV1Update(ctx context.Context, d *schema.ResourceData,
 		cluster.Spec.Openstack.SecurityGroupName = v.(string)
 	}
 
+	// serialize the node pool changes with the sci_kubernetes_node_pool_v1
+	// resources and keep the node pools managed by them
 	o, n := d.GetChange("node_pools")
+	klient.nodePools = &kubernikusNodePoolsLockV1{
+		config:  config,
+		key:     kubernikusClusterMutexKey(GetRegion(d, config), cluster.Name),
+		managed: kubernikusNodePoolNamesV1(o, n),
+	}
 
 	// wait for the cluster to be upgraded, when new API version was specified
 	target := string(models.KlusterPhaseRunning)
@@ -562,6 +812,36 @@ func resourceSCIKubernetesV1Update(ctx context.Context, d *schema.ResourceData,
 		string(models.KlusterPhaseUpgrading),
 		string(models.KlusterPhaseTerminating),
 	}
+
+	if d.HasChange("version") && cluster.Spec.Version != "" && d.Get("upgrade_strategy").(string) == kubernikusUpgradeStepwise {
+		deadline := time.Now().Add(timeout)
+		err = kubernikusUpgradeStepwiseV1(ctx, klient, cluster.Name, cluster.Spec.Version, pending, timeout)
+		if err != nil {
+			// keep the previous version in the state, so that the next
+			// apply resumes the upgrade
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+		// the final step gets the remaining time
+		timeout = time.Until(deadline)
+	}
+
+	if !d.HasChange("node_pools") {
+		// keep the live node pools
+		result, err := klient.ShowCluster(operations.NewShowClusterParams().WithName(cluster.Name), klient.authFunc())
+		if err != nil {
+			return diag.FromErr(kubernikusHandleErrorV1("Error reading Kubernikus cluster", err))
+		}
+		cluster.Spec.NodePools = result.Payload.Spec.NodePools
+
+		err = kubernikusUpdateAndWait(ctx, klient, cluster, target, pending, timeout)
+		if err != nil {
+			return diag.FromErr(kubernikusHandleErrorV1("Error waiting for cluster to be updated", err))
+		}
+
+		return resourceSCIKubernetesV1Read(ctx, d, meta)
+	}
+
 	err = kubernikusUpdateNodePoolsV1(ctx, klient, cluster, o, n, target, pending, timeout)
 	if err != nil {
 		return diag.FromErr(kubernikusHandleErrorV1("Error waiting for cluster to be updated", err))
@@ -570,15 +850,28 @@ func resourceSCIKubernetesV1Update(ctx context.Context, d *schema.ResourceData,
 	return resourceSCIKubernetesV1Read(ctx, d, meta)
 }
 
-func resourceSCIKubernetesV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceSCIKubernetesV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	config := meta.(*Config)
 	log.Printf("[KUBERNETES] Deleting Kubernikus Kluster in project %s", config.TenantID)
 
+	if d.Get("deletion_protection").(bool) {
+		return diag.Errorf("Error deleting Kubernikus cluster %s: deletion_protection is enabled", d.Id())
+	}
+
+	if d.Get("on_destroy").(string) == kubernikusOnDestroyOrphan {
+		log.Printf("[KUBERNETES] Removing Kubernikus cluster %s from the state without terminating it", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	klient, err := config.kubernikusV1Client(ctx, GetRegion(d, config), d.Get("is_admin").(bool))
 	if err != nil {
 		return diag.Errorf("Error creating Kubernikus client: %s", err)
 	}
 
+	// report the warning events of the operation
+	defer func() { diags = append(diags, klient.events.diagnostics()...) }()
+
 	timeout := d.Timeout(schema.TimeoutDelete)
 
 	_, err = klient.TerminateCluster(operations.NewTerminateClusterParams().WithName(d.Id()), klient.authFunc())
@@ -620,8 +913,28 @@ func resourceSCIKubernetesV1Import(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	config := meta.(*Config)
+	klient, err := config.kubernikusV1Client(ctx, GetRegion(d, config), isAdmin)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating Kubernikus client: %s", err)
+	}
+
+	// adopt all live node pools, use the sci_kubernetes_node_pool_v1 import
+	// for the node pools, which should be managed separately
+	result, err := klient.ShowCluster(operations.NewShowClusterParams().WithName(name), klient.authFunc())
+	if err != nil {
+		return nil, kubernikusHandleErrorV1("Error reading Kubernikus cluster", err)
+	}
+
 	d.SetId(name)
 	_ = d.Set("is_admin", isAdmin)
+	_ = d.Set("node_pools", kubernikusFlattenNodePoolsV1(result.Payload.Spec.NodePools))
+	_ = d.Set("kube_config_renew_before", "0s")
+	_ = d.Set("upgrade_strategy", kubernikusUpgradeDirect)
+	_ = d.Set("wait_for_apiserver", false)
+	_ = d.Set("wait_for_ready_nodes", 0)
+	_ = d.Set("deletion_protection", false)
+	_ = d.Set("on_destroy", kubernikusOnDestroyDelete)
 
 	return []*schema.ResourceData{d}, nil
 }
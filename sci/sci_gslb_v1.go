@@ -0,0 +1,287 @@
+package sci
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sapcc/andromeda/client/domains"
+	"github.com/sapcc/andromeda/models"
+)
+
+// andromedaDataSourceSchemaV1 converts the Andromeda resource schema into a
+// computed data source schema. The filter attributes remain optional.
+func andromedaDataSourceSchemaV1(rs map[string]*schema.Schema, filters ...string) map[string]*schema.Schema {
+	res := make(map[string]*schema.Schema, len(rs))
+	for k, v := range rs {
+		s := andromedaComputedSchemaV1(v)
+		if strSliceContains(filters, k) {
+			s.Optional = true
+			s.ValidateFunc = v.ValidateFunc
+		}
+		res[k] = s
+	}
+	return res
+}
+
+func andromedaComputedSchemaV1(v *schema.Schema) *schema.Schema {
+	s := &schema.Schema{
+		Type:      v.Type,
+		Computed:  true,
+		Sensitive: v.Sensitive,
+		Elem:      v.Elem,
+	}
+	if r, ok := v.Elem.(*schema.Resource); ok {
+		elem := make(map[string]*schema.Schema, len(r.Schema))
+		for k, v := range r.Schema {
+			elem[k] = andromedaComputedSchemaV1(v)
+		}
+		s.Elem = &schema.Resource{Schema: elem}
+	}
+	return s
+}
+
+// andromedaListSchemaV1 returns the schema of the list data source objects,
+// which contain all resource attributes and the ID.
+func andromedaListSchemaV1(rs map[string]*schema.Schema) map[string]*schema.Schema {
+	res := andromedaDataSourceSchemaV1(rs)
+	res["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	return res
+}
+
+// andromedaFlattenV1 flattens the Andromeda object into a map using the
+// resource setter.
+func andromedaFlattenV1[T any](s map[string]*schema.Schema, config *Config, id string, v T, set func(*schema.ResourceData, *Config, T)) map[string]interface{} {
+	d := (&schema.Resource{Schema: s}).Data(nil)
+	set(d, config, v)
+
+	res := make(map[string]interface{}, len(s))
+	for k := range s {
+		res[k] = d.Get(k)
+	}
+	res["id"] = id
+
+	return res
+}
+
+// andromedaMatchV1 returns true, when the filter attribute is not set or
+// matches the value.
+func andromedaMatchV1(d *schema.ResourceData, key, value string) bool {
+	v, ok := d.GetOk(key)
+	return !ok || v.(string) == value
+}
+
+// andromedaMatchUUIDsV1 returns true, when the filter attribute is not set or
+// is contained in the values.
+func andromedaMatchUUIDsV1(d *schema.ResourceData, key string, values []strfmt.UUID) bool {
+	v, ok := d.GetOk(key)
+	return !ok || sliceContains(values, strfmt.UUID(v.(string)))
+}
+
+func andromedaLinksHaveNext(links []*models.Link) bool {
+	for _, l := range links {
+		if l != nil && l.Rel == "next" {
+			return true
+		}
+	}
+	return false
+}
+
+func andromedaIDsHash(ids []string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(ids, ","))))
+}
+
+func andromedaSingleResultV1(kind string, count int) error {
+	if count < 1 {
+		return fmt.Errorf("no Andromeda %s found matching the criteria", kind)
+	}
+	if count > 1 {
+		return fmt.Errorf("more than one Andromeda %s found matching the criteria (%d)", kind, count)
+	}
+	return nil
+}
+
+// andromedaFilterSchemaV1 returns the schema of the list data source with the
+// filter attributes taken from the resource schema.
+func andromedaFilterSchemaV1(rs map[string]*schema.Schema, filters ...string) map[string]*schema.Schema {
+	res := make(map[string]*schema.Schema, len(filters)+1)
+	for _, k := range filters {
+		res[k] = &schema.Schema{
+			Type:         rs[k].Type,
+			Optional:     true,
+			Computed:     k == "region",
+			ValidateFunc: rs[k].ValidateFunc,
+		}
+	}
+	res["ids"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	return res
+}
+
+// andromedaContinentCountriesV1 maps the continent codes to the officially
+// assigned ISO 3166-1 alpha-2 country codes.
+var andromedaContinentCountriesV1 = map[string][]string{
+	"AF": {
+		"AO", "BF", "BI", "BJ", "BW", "CD", "CF", "CG", "CI", "CM", "CV", "DJ",
+		"DZ", "EG", "EH", "ER", "ET", "GA", "GH", "GM", "GN", "GQ", "GW", "KE",
+		"KM", "LR", "LS", "LY", "MA", "MG", "ML", "MR", "MU", "MW", "MZ", "NA",
+		"NE", "NG", "RE", "RW", "SC", "SD", "SH", "SL", "SN", "SO", "SS", "ST",
+		"SZ", "TD", "TG", "TN", "TZ", "UG", "YT", "ZA", "ZM", "ZW",
+	},
+	"AN": {
+		"AQ", "BV", "GS", "HM", "TF",
+	},
+	"AS": {
+		"AE", "AF", "AM", "AZ", "BD", "BH", "BN", "BT", "CC", "CN", "CX", "CY",
+		"GE", "HK", "ID", "IL", "IN", "IO", "IQ", "IR", "JO", "JP", "KG", "KH",
+		"KP", "KR", "KW", "KZ", "LA", "LB", "LK", "MM", "MN", "MO", "MV", "MY",
+		"NP", "OM", "PH", "PK", "PS", "QA", "SA", "SG", "SY", "TH", "TJ", "TL",
+		"TM", "TR", "TW", "UZ", "VN", "YE",
+	},
+	"EU": {
+		"AD", "AL", "AT", "AX", "BA", "BE", "BG", "BY", "CH", "CZ", "DE", "DK",
+		"EE", "ES", "FI", "FO", "FR", "GB", "GG", "GI", "GR", "HR", "HU", "IE",
+		"IM", "IS", "IT", "JE", "LI", "LT", "LU", "LV", "MC", "MD", "ME", "MK",
+		"MT", "NL", "NO", "PL", "PT", "RO", "RS", "RU", "SE", "SI", "SJ", "SK",
+		"SM", "UA", "VA",
+	},
+	"NA": {
+		"AG", "AI", "AW", "BB", "BL", "BM", "BQ", "BS", "BZ", "CA", "CR", "CU",
+		"CW", "DM", "DO", "GD", "GL", "GP", "GT", "HN", "HT", "JM", "KN", "KY",
+		"LC", "MF", "MQ", "MS", "MX", "NI", "PA", "PM", "PR", "SV", "SX", "TC",
+		"TT", "US", "VC", "VG", "VI",
+	},
+	"OC": {
+		"AS", "AU", "CK", "FJ", "FM", "GU", "KI", "MH", "MP", "NC", "NF", "NR",
+		"NU", "NZ", "PF", "PG", "PN", "PW", "SB", "TK", "TO", "TV", "UM", "VU",
+		"WF", "WS",
+	},
+	"SA": {
+		"AR", "BO", "BR", "CL", "CO", "EC", "FK", "GF", "GY", "PE", "PY", "SR",
+		"UY", "VE",
+	},
+}
+
+// andromedaCountryContinentV1 returns the continent code of the country or an
+// empty string, when the country code is unknown.
+func andromedaCountryContinentV1(country string) string {
+	for continent, countries := range andromedaContinentCountriesV1 {
+		if sliceContains(countries, country) {
+			return continent
+		}
+	}
+	return ""
+}
+
+func andromedaValidateCountryCodeV1(v interface{}, k string) (ws []string, errors []error) {
+	if andromedaCountryContinentV1(v.(string)) == "" {
+		errors = append(errors, fmt.Errorf("%q must be an uppercase ISO 3166-1 alpha-2 country code, got: %q", k, v))
+	}
+	return
+}
+
+// andromedaValidateMonitorV1 validates the monitor attributes under the
+// prefix, which is empty for the monitor resource and "monitor.N." for the
+// pool inline monitors.
+func andromedaValidateMonitorV1(diff *schema.ResourceDiff, prefix string) []error {
+	key := prefix + "type"
+	if !diff.NewValueKnown(key) {
+		return nil
+	}
+	monitorType := diff.Get(key).(string)
+
+	var errs []error
+	isSet := func(k string) bool {
+		return diff.NewValueKnown(prefix+k) && diff.Get(prefix+k).(string) != ""
+	}
+	if monitorType == "ICMP" || monitorType == "TCP" {
+		for _, k := range []string{"send", "receive"} {
+			if isSet(k) {
+				errs = append(errs, fmt.Errorf("%s%s: not supported by the %s monitor type", prefix, k, monitorType))
+			}
+		}
+	}
+	if monitorType != "HTTP" && monitorType != "HTTPS" {
+		if isSet("domain_name") {
+			errs = append(errs, fmt.Errorf("%sdomain_name: only supported by the HTTP and HTTPS monitor types", prefix))
+		}
+		if k := prefix + "http_method"; diff.NewValueKnown(k) && diff.Get(k).(string) != "GET" {
+			errs = append(errs, fmt.Errorf("%s: only supported by the HTTP and HTTPS monitor types", k))
+		}
+	}
+
+	interval := -1
+	if diff.NewValueKnown(prefix + "interval") {
+		interval = diff.Get(prefix + "interval").(int)
+		if interval != 0 && (interval < 10 || interval > 86399) {
+			errs = append(errs, fmt.Errorf("%sinterval: must be between 10 and 86399 seconds, got: %d", prefix, interval))
+		}
+	}
+	if !diff.NewValueKnown(prefix + "timeout") {
+		return errs
+	}
+	timeout := diff.Get(prefix + "timeout").(int)
+	if timeout < 0 || timeout > 60 {
+		errs = append(errs, fmt.Errorf("%stimeout: must be between 0 and 60 seconds, got: %d", prefix, timeout))
+	}
+	// the interval is -1, when it is not known yet
+	if interval > 0 && timeout != 0 && timeout >= interval {
+		errs = append(errs, fmt.Errorf("%stimeout: must be lower than the %d seconds interval, got: %d", prefix, interval, timeout))
+	}
+
+	return errs
+}
+
+// andromedaAddressMatchesRecordTypeV1 returns false, when the IP address family
+// doesn't match the A or AAAA record type. Hostnames and other record types
+// always match.
+func andromedaAddressMatchesRecordTypeV1(recordType, address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return true
+	}
+	switch recordType {
+	case models.DomainRecordTypeA:
+		return ip.To4() != nil
+	case models.DomainRecordTypeAAAA:
+		return ip.To4() == nil
+	}
+	return true
+}
+
+// andromedaGetRecordTypesV1 returns the record types of the domains. Missing
+// domains are skipped.
+func andromedaGetRecordTypesV1(ctx context.Context, client domains.ClientService, ids []strfmt.UUID) (map[strfmt.UUID]string, error) {
+	res := make(map[strfmt.UUID]string, len(ids))
+	for _, id := range ids {
+		domain, err := andromedaGetDomain(ctx, client, id.String())
+		if err != nil {
+			if _, ok := err.(*domains.GetDomainsDomainIDNotFound); ok {
+				log.Printf("[DEBUG] Andromeda domain %s not found, skipping the record type validation", id)
+				continue
+			}
+			return nil, fmt.Errorf("error fetching Andromeda domain %s: %s", id, err)
+		}
+		res[id] = ptrValue(domain.RecordType)
+	}
+	return res, nil
+}
+
+// andromedaDiffRegion returns the region of the resource diff.
+func andromedaDiffRegion(diff *schema.ResourceDiff, config *Config) string {
+	if v, ok := diff.GetOk("region"); ok {
+		return v.(string)
+	}
+	return config.Region
+}
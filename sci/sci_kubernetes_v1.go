@@ -10,13 +10,25 @@ import (
 	"log"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/sapcc/kubernikus/pkg/api/client/operations"
 	"github.com/sapcc/kubernikus/pkg/api/models"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api/v1"
 	"sigs.k8s.io/yaml"
 )
@@ -24,6 +36,17 @@ import (
 const (
 	klusterNameRegex = "^[a-z][-a-z0-9]{0,18}[a-z0-9]?$"
 	poolNameRegex    = "^[a-z][-\\.a-z0-9]{0,18}[a-z0-9]?$"
+	poolNameMaxLen   = 20
+
+	kubernikusRolloverRecreate  = "recreate"
+	kubernikusRolloverBlueGreen = "blue_green"
+	kubernikusSurgePoolSuffix   = "-rs"
+
+	kubernikusUpgradeDirect   = "direct"
+	kubernikusUpgradeStepwise = "stepwise"
+
+	kubernikusOnDestroyDelete = "delete"
+	kubernikusOnDestroyOrphan = "orphan"
 )
 
 func kubernikusValidateClusterName(v interface{}, k string) (ws []string, errors []error) {
@@ -63,6 +86,107 @@ func kubernikusValidateAuthConf(v any, k string) ([]string, []error) {
 	return nil, nil
 }
 
+func kubernikusValidateLabelKey(v interface{}, k string) (ws []string, errors []error) {
+	for _, msg := range k8svalidation.IsQualifiedName(v.(string)) {
+		errors = append(errors, fmt.Errorf("%q: %s", k, msg))
+	}
+	return
+}
+
+func kubernikusValidateLabelValue(v interface{}, k string) (ws []string, errors []error) {
+	for _, msg := range k8svalidation.IsValidLabelValue(v.(string)) {
+		errors = append(errors, fmt.Errorf("%q: %s", k, msg))
+	}
+	return
+}
+
+func kubernikusValidateLabels(v interface{}, k string) (ws []string, errors []error) {
+	for key, value := range v.(map[string]interface{}) {
+		_, errs := kubernikusValidateLabelKey(key, fmt.Sprintf("%s.%s", k, key))
+		errors = append(errors, errs...)
+		_, errs = kubernikusValidateLabelValue(value, fmt.Sprintf("%s.%s", k, key))
+		errors = append(errors, errs...)
+	}
+	return
+}
+
+func kubernikusNodePoolTaintSchemaV1() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: kubernikusValidateLabelKey,
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: kubernikusValidateLabelValue,
+			},
+			"effect": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"NoSchedule", "PreferNoSchedule", "NoExecute",
+				}, false),
+			},
+		},
+	}
+}
+
+// kubernikusExpandTaintsV1 converts the taint blocks into the Kubernikus
+// "key=value:effect" format.
+func kubernikusExpandTaintsV1(raw []interface{}) []string {
+	res := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if v, ok := v.(map[string]interface{}); ok {
+			taint := v["key"].(string)
+			if value, _ := v["value"].(string); value != "" {
+				taint += "=" + value
+			}
+			res = append(res, taint+":"+v["effect"].(string))
+		}
+	}
+	return res
+}
+
+func kubernikusFlattenTaintsV1(taints []string) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(taints))
+	for _, t := range taints {
+		var effect string
+		if i := strings.LastIndex(t, ":"); i >= 0 {
+			t, effect = t[:i], t[i+1:]
+		}
+		key, value, _ := strings.Cut(t, "=")
+		res = append(res, map[string]interface{}{
+			"key":    key,
+			"value":  value,
+			"effect": effect,
+		})
+	}
+	return res
+}
+
+// kubernikusExpandLabelsV1 converts the labels map into the Kubernikus
+// "key=value" format, sorted by the key.
+func kubernikusExpandLabelsV1(raw map[string]interface{}) []string {
+	res := make([]string, 0, len(raw))
+	for k, v := range raw {
+		res = append(res, k+"="+v.(string))
+	}
+	sort.Strings(res)
+	return res
+}
+
+func kubernikusFlattenLabelsV1(labels []string) map[string]string {
+	res := make(map[string]string, len(labels))
+	for _, l := range labels {
+		key, value, _ := strings.Cut(l, "=")
+		res[key] = value
+	}
+	return res
+}
+
 func kubernikusFlattenOpenstackSpecV1(spec *models.OpenstackSpec) []map[string]interface{} {
 	var res []map[string]interface{}
 
@@ -88,8 +212,8 @@ func kubernikusFlattenNodePoolsV1(nodePools []models.NodePool) []map[string]inte
 			"image":                 p.Image,
 			"name":                  p.Name,
 			"size":                  p.Size,
-			"taints":                p.Taints,
-			"labels":                p.Labels,
+			"taint":                 kubernikusFlattenTaintsV1(p.Taints),
+			"labels":                kubernikusFlattenLabelsV1(p.Labels),
 			"custom_root_disk_size": p.CustomRootDiskSize,
 			"config": []map[string]interface{}{
 				{
@@ -102,6 +226,45 @@ func kubernikusFlattenNodePoolsV1(nodePools []models.NodePool) []map[string]inte
 	return res
 }
 
+func kubernikusNodePoolStatusSchemaV1() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"size": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"running": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"healthy": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"schedulable": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func kubernikusFlattenNodePoolsStatusV1(nodePools []models.NodePoolInfo) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(nodePools))
+	for _, p := range nodePools {
+		res = append(res, map[string]interface{}{
+			"name":        p.Name,
+			"size":        p.Size,
+			"running":     p.Running,
+			"healthy":     p.Healthy,
+			"schedulable": p.Schedulable,
+		})
+	}
+	return res
+}
+
 func kubernikusExpandOpenstackSpecV1(raw interface{}) *models.OpenstackSpec {
 	if raw != nil {
 		if v, ok := raw.([]interface{}); ok {
@@ -143,39 +306,11 @@ func kubernikusExpandNodePoolsV1(raw interface{}) ([]models.NodePool, error) {
 
 			for _, v := range v {
 				if v, ok := v.(map[string]interface{}); ok {
-					var p models.NodePool
-
-					if v, ok := v["name"]; ok {
-						p.Name = v.(string)
-						if strSliceContains(names, p.Name) {
-							return nil, fmt.Errorf("duplicate node pool name found: %s", p.Name)
-						}
-						names = append(names, p.Name)
-					}
-					if v, ok := v["flavor"]; ok {
-						p.Flavor = v.(string)
-					}
-					if v, ok := v["image"]; ok {
-						p.Image = v.(string)
-					}
-					if v, ok := v["size"]; ok {
-						p.Size = int64(v.(int))
-					}
-					if v, ok := v["availability_zone"]; ok {
-						p.AvailabilityZone = v.(string)
-					}
-					if v, ok := v["taints"]; ok {
-						p.Taints = expandToStringSlice(v.([]interface{}))
-					}
-					if v, ok := v["labels"]; ok {
-						p.Labels = expandToStringSlice(v.([]interface{}))
-					}
-					if v, ok := v["custom_root_disk_size"]; ok {
-						p.CustomRootDiskSize = int64(v.(int))
-					}
-					if v, ok := v["config"]; ok {
-						p.Config = expandToNodePoolConfig(v.([]interface{}))
+					p := kubernikusExpandNodePoolV1(v)
+					if strSliceContains(names, p.Name) {
+						return nil, fmt.Errorf("duplicate node pool name found: %s", p.Name)
 					}
+					names = append(names, p.Name)
 
 					res = append(res, p)
 				}
@@ -188,6 +323,106 @@ func kubernikusExpandNodePoolsV1(raw interface{}) ([]models.NodePool, error) {
 	return nil, nil
 }
 
+func kubernikusExpandNodePoolV1(v map[string]interface{}) models.NodePool {
+	var p models.NodePool
+
+	if v, ok := v["name"]; ok {
+		p.Name = v.(string)
+	}
+	if v, ok := v["flavor"]; ok {
+		p.Flavor = v.(string)
+	}
+	if v, ok := v["image"]; ok {
+		p.Image = v.(string)
+	}
+	if v, ok := v["size"]; ok {
+		p.Size = int64(v.(int))
+	}
+	if v, ok := v["availability_zone"]; ok {
+		p.AvailabilityZone = v.(string)
+	}
+	if v, ok := v["taint"]; ok {
+		p.Taints = kubernikusExpandTaintsV1(v.([]interface{}))
+	}
+	if v, ok := v["labels"]; ok {
+		p.Labels = kubernikusExpandLabelsV1(v.(map[string]interface{}))
+	}
+	if v, ok := v["custom_root_disk_size"]; ok {
+		p.CustomRootDiskSize = int64(v.(int))
+	}
+	if v, ok := v["config"]; ok {
+		p.Config = expandToNodePoolConfig(v.([]interface{}))
+	}
+
+	return p
+}
+
+// kubernikusClusterMutexKey returns the MutexKV key, which serializes the
+// read-modify-write operations on the node pools of a single cluster.
+func kubernikusClusterMutexKey(region, name string) string {
+	return fmt.Sprintf("kubernikus/%s/%s", region, name)
+}
+
+// kubernikusNodePoolsLockV1 serializes the cluster updates with the
+// sci_kubernetes_node_pool_v1 resources. The lock is held during the
+// read-modify-write of the node pools only, not while waiting for them.
+type kubernikusNodePoolsLockV1 struct {
+	config *Config
+	key    string
+	// managed are the node pool names managed by the cluster resource
+	managed map[string]bool
+}
+
+// kubernikusNodePoolNamesV1 returns the names of the raw node pools.
+func kubernikusNodePoolNamesV1(raws ...interface{}) map[string]bool {
+	res := make(map[string]bool)
+	for _, raw := range raws {
+		if v, ok := raw.([]interface{}); ok {
+			for _, v := range v {
+				if v, ok := v.(map[string]interface{}); ok {
+					if name, _ := v["name"].(string); name != "" {
+						res[name] = true
+					}
+				}
+			}
+		}
+	}
+	return res
+}
+
+// kubernikusMergeNodePoolsV1 returns the managed node pools followed by the
+// live node pools, which are not managed by the cluster resource.
+func kubernikusMergeNodePoolsV1(nodePools, live []models.NodePool, managed map[string]bool) []models.NodePool {
+	res := make([]models.NodePool, 0, len(nodePools)+len(live))
+	for _, p := range nodePools {
+		if managed[p.Name] {
+			res = append(res, p)
+		}
+	}
+	for _, p := range live {
+		if !managed[p.Name] {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+func kubernikusIsNotFoundV1(err error) bool {
+	if e, ok := err.(*operations.ShowClusterDefault); ok && e.Payload != nil && e.Payload.Message == "Not found" {
+		return true
+	}
+	return false
+}
+
+func kubernikusFindNodePoolV1(nodePools []models.NodePool, name string) (int, *models.NodePool) {
+	for i := range nodePools {
+		if nodePools[i].Name == name {
+			return i, &nodePools[i]
+		}
+	}
+	return -1, nil
+}
+
 func kubernikusWaitForClusterV1(ctx context.Context, klient *kubernikus, name string, target string, pending []string, timeout time.Duration) error {
 	// Phase: "Pending","Creating","Running","Terminating","Upgrading"
 	log.Printf("[DEBUG] Waiting for %s cluster to become %s.", name, target)
@@ -220,25 +455,22 @@ func kubernikusKlusterV1GetPhase(klient *kubernikus, target string, name string)
 		}
 
 		if target != "Terminated" {
-			events, err := klient.GetClusterEvents(operations.NewGetClusterEventsParams().WithName(name), klient.authFunc())
-			if err != nil {
+			if event, err := kubernikusObserveEventsV1(klient, name); err != nil {
 				return nil, "", err
+			} else if event != nil {
+				return nil, event.Reason, fmt.Errorf("%s", event.Message)
 			}
 
-			if len(events.Payload) > 0 {
-				// check, whether there are error events
-				event := events.Payload[len(events.Payload)-1]
-
-				if strings.Contains(event.Reason, "Error") || strings.Contains(event.Reason, "Failed") {
-					return nil, event.Reason, fmt.Errorf("%s", event.Message)
-				}
+			// workaround for the upgrade status race condition
+			if result.Payload.Status.Phase == models.KlusterPhaseRunning &&
+				result.Payload.Spec.Version != result.Payload.Status.ApiserverVersion {
+				return result.Payload, string(models.KlusterPhaseUpgrading), nil
 			}
 
 			for _, a := range result.Payload.Spec.NodePools {
-				// workaround for the upgrade status race condition
-				if result.Payload.Status.Phase == models.KlusterPhaseRunning &&
-					result.Payload.Spec.Version != result.Payload.Status.ApiserverVersion {
-					return result.Payload, string(models.KlusterPhaseUpgrading), nil
+				// the user doesn't want to wait for this pool
+				if klient.skipHealthy[a.Name] {
+					continue
 				}
 
 				for _, s := range result.Payload.Status.NodePools {
@@ -260,6 +492,255 @@ func kubernikusKlusterV1GetPhase(klient *kubernikus, target string, name string)
 	}
 }
 
+// kubernikusWaitForAPIServerV1 waits for the Kubernetes API server of the
+// cluster to report its readiness and, when readyNodes is set, for the
+// amount of Ready nodes.
+func kubernikusWaitForAPIServerV1(ctx context.Context, name, kubeConfigRaw string, readyNodes int, timeout time.Duration) error {
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeConfigRaw))
+	if err != nil {
+		return fmt.Errorf("failed to parse the Kubernikus kubeconfig: %s", err)
+	}
+	restConfig.Timeout = 10 * time.Second
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create the Kubernetes client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for %s cluster API server to become ready.", name)
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{"Ready"},
+		Pending:    []string{"Pending"},
+		Refresh:    kubernikusAPIServerV1GetPhase(ctx, clientset, name, readyNodes),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func kubernikusAPIServerV1GetPhase(ctx context.Context, clientset kubernetes.Interface, name string, readyNodes int) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		// the API server may be unreachable or may not serve its certificates yet
+		body, err := clientset.Discovery().RESTClient().Get().AbsPath("/readyz").DoRaw(ctx)
+		if err != nil {
+			log.Printf("[DEBUG] The %s cluster API server is not ready: %s: %s", name, err, body)
+			return name, "Pending", nil
+		}
+
+		if readyNodes == 0 {
+			return name, "Ready", nil
+		}
+
+		nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			log.Printf("[DEBUG] Failed to list the %s cluster nodes: %s", name, err)
+			return name, "Pending", nil
+		}
+
+		var ready int
+		for _, n := range nodes.Items {
+			for _, c := range n.Status.Conditions {
+				if c.Type == corev1.NodeReady && c.Status == corev1.ConditionTrue {
+					ready++
+				}
+			}
+		}
+
+		log.Printf("[DEBUG] %d of %d required %s cluster nodes are Ready", ready, readyNodes, name)
+		if ready < readyNodes {
+			return name, "Pending", nil
+		}
+
+		return name, "Ready", nil
+	}
+}
+
+// kubernikusFatalEventReasons are the Kubernikus event reasons, which abort
+// the wait for a cluster or node pool state.
+var kubernikusFatalEventReasons = []string{
+	"ConfigurationError",
+	"FailedCreate",
+	"FailedUpgrade",
+	"MigrationFailed",
+	"FailedCreateNode",
+	"FailedDeleteNode",
+}
+
+const (
+	// kubernikusEventTimeLayout is the format of the Kubernikus event
+	// timestamps
+	kubernikusEventTimeLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+	// kubernikusEventClockSkew is the tolerated clock difference between the
+	// provider and Kubernikus, when the operation events are filtered
+	kubernikusEventClockSkew = 1 * time.Minute
+	// kubernikusEventsLimit is the amount of the most recent events kept in
+	// the state
+	kubernikusEventsLimit = 10
+)
+
+// kubernikusEventsV1 tracks the cluster events of a single operation. It
+// streams the new events into the log and collects the warning events.
+type kubernikusEventsV1 struct {
+	since    time.Time
+	seen     map[string]int64
+	warnings []*models.Event
+}
+
+func newKubernikusEventsV1() *kubernikusEventsV1 {
+	return &kubernikusEventsV1{
+		since: time.Now().Add(-kubernikusEventClockSkew),
+		seen:  make(map[string]int64),
+	}
+}
+
+// observe logs the events, which were not seen yet, and returns the most
+// recent fatal event.
+func (e *kubernikusEventsV1) observe(name string, events []*models.Event) *models.Event {
+	var fatal *models.Event
+	for _, event := range kubernikusSortEventsV1(events) {
+		if t, err := time.Parse(kubernikusEventTimeLayout, event.LastTimestamp); err == nil && t.Before(e.since) {
+			continue
+		}
+
+		key := strings.Join([]string{event.Reason, event.FirstTimestamp, event.Message}, "/")
+		count, ok := e.seen[key]
+		if ok && count >= event.Count {
+			continue
+		}
+		e.seen[key] = event.Count
+
+		log.Printf("[KUBERNETES] %s cluster event: type=%s reason=%s count=%d last=%s: %s", name, event.Type, event.Reason, event.Count, event.LastTimestamp, event.Message)
+
+		if !ok && event.Type == "Warning" {
+			e.warnings = append(e.warnings, event)
+		}
+		if strSliceContains(kubernikusFatalEventReasons, event.Reason) {
+			fatal = event
+		}
+	}
+	return fatal
+}
+
+// diagnostics returns the warning events as warning diagnostics.
+func (e *kubernikusEventsV1) diagnostics() diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, event := range e.warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Kubernikus cluster event: %s", event.Reason),
+			Detail:   fmt.Sprintf("%s (count: %d, last seen: %s)", event.Message, event.Count, event.LastTimestamp),
+		})
+	}
+	return diags
+}
+
+func kubernikusObserveEventsV1(klient *kubernikus, name string) (*models.Event, error) {
+	events, err := klient.GetClusterEvents(operations.NewGetClusterEventsParams().WithName(name), klient.authFunc())
+	if err != nil {
+		return nil, err
+	}
+	return klient.events.observe(name, events.Payload), nil
+}
+
+// kubernikusSortEventsV1 returns the non-nil events sorted by their last
+// timestamp.
+func kubernikusSortEventsV1(events []*models.Event) []*models.Event {
+	res := make([]*models.Event, 0, len(events))
+	for _, event := range events {
+		if event != nil {
+			res = append(res, event)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		ti, _ := time.Parse(kubernikusEventTimeLayout, res[i].LastTimestamp)
+		tj, _ := time.Parse(kubernikusEventTimeLayout, res[j].LastTimestamp)
+		return ti.Before(tj)
+	})
+	return res
+}
+
+// kubernikusFlattenEventsV1 returns the most recent events.
+func kubernikusFlattenEventsV1(events []*models.Event) []map[string]interface{} {
+	events = kubernikusSortEventsV1(events)
+	if len(events) > kubernikusEventsLimit {
+		events = events[len(events)-kubernikusEventsLimit:]
+	}
+
+	res := make([]map[string]interface{}, 0, len(events))
+	for _, event := range events {
+		res = append(res, map[string]interface{}{
+			"type":            event.Type,
+			"reason":          event.Reason,
+			"message":         event.Message,
+			"count":           event.Count,
+			"first_timestamp": event.FirstTimestamp,
+			"last_timestamp":  event.LastTimestamp,
+		})
+	}
+	return res
+}
+
+func kubernikusWaitForNodePoolV1(ctx context.Context, klient *kubernikus, cluster, pool string, target string, timeout time.Duration) error {
+	// Phase: "Pending","Running","Deleted"
+	log.Printf("[DEBUG] Waiting for %s/%s node pool to become %s.", cluster, pool, target)
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{target},
+		Pending:    []string{"Pending"},
+		Refresh:    kubernikusNodePoolV1GetPhase(klient, cluster, pool),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func kubernikusNodePoolV1GetPhase(klient *kubernikus, cluster, pool string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		result, err := klient.ShowCluster(operations.NewShowClusterParams().WithName(cluster), klient.authFunc())
+		if err != nil {
+			return nil, "", err
+		}
+
+		if event, err := kubernikusObserveEventsV1(klient, cluster); err != nil {
+			return nil, "", err
+		} else if event != nil {
+			return nil, event.Reason, fmt.Errorf("%s", event.Message)
+		}
+
+		_, spec := kubernikusFindNodePoolV1(result.Payload.Spec.NodePools, pool)
+
+		var status *models.NodePoolInfo
+		for i, s := range result.Payload.Status.NodePools {
+			if s.Name == pool {
+				status = &result.Payload.Status.NodePools[i]
+			}
+		}
+
+		if spec == nil {
+			if status == nil {
+				return result.Payload, "Deleted", nil
+			}
+			return result.Payload, "Pending", nil
+		}
+
+		// sometimes status size doesn't reflect the actual size, therefore we use "spec.Size"
+		if status == nil || spec.Size != status.Healthy {
+			return result.Payload, "Pending", nil
+		}
+
+		return result.Payload, "Running", nil
+	}
+}
+
 func kubernikusUpdateNodePoolsV1(ctx context.Context, klient *kubernikus, cluster *models.Kluster, oldNodePoolsRaw, newNodePoolsRaw interface{}, target string, pending []string, timeout time.Duration) error {
 	var poolsToKeep []models.NodePool
 	var poolsToDelete []models.NodePool
@@ -272,6 +753,12 @@ func kubernikusUpdateNodePoolsV1(ctx context.Context, klient *kubernikus, cluste
 		return err
 	}
 
+	// keep the live size of the externally scaled node pools
+	err = kubernikusKeepExternalNodePoolsSizeV1(klient, cluster.Name, kubernikusExpandNodePoolsSizeOptionsV1(newNodePoolsRaw), oldNodePools, newNodePools)
+	if err != nil {
+		return err
+	}
+
 	pretty, err := json.MarshalIndent(oldNodePools, "", "  ")
 	if err != nil {
 		return err
@@ -283,6 +770,34 @@ func kubernikusUpdateNodePoolsV1(ctx context.Context, klient *kubernikus, cluste
 	}
 	log.Printf("[DEBUG] New node pools: %s", string(pretty))
 
+	// Roll over the node pools with a changed flavor or image, which use the
+	// blue_green strategy, before the regular reconciliation below.
+	rollover := kubernikusExpandNodePoolsRolloverV1(newNodePoolsRaw)
+	for i, op := range oldNodePools {
+		for _, np := range newNodePools {
+			if op.Name != np.Name || rollover[np.Name] != kubernikusRolloverBlueGreen {
+				continue
+			}
+			if op.Flavor == np.Flavor && op.Image == np.Image {
+				continue
+			}
+			if np.AvailabilityZone != "" && op.AvailabilityZone != np.AvailabilityZone {
+				continue
+			}
+
+			tmp := np
+			// copy previously "computed" AZ
+			if np.AvailabilityZone == "" {
+				tmp.AvailabilityZone = op.AvailabilityZone
+			}
+			err = kubernikusRolloverNodePoolV1(ctx, klient, cluster, oldNodePools, op, tmp, target, pending, timeout)
+			if err != nil {
+				return err
+			}
+			oldNodePools[i] = tmp
+		}
+	}
+
 	// Determine if any node pools removed from the configuration.
 	// Then downscale those pools and delete.
 	for _, op := range oldNodePools {
@@ -339,6 +854,215 @@ func kubernikusUpdateNodePoolsV1(ctx context.Context, klient *kubernikus, cluste
 	return nil
 }
 
+// kubernikusRolloverNodePoolV1 replaces the node pool with a new flavor or
+// image without losing its capacity. A temporary surge node pool with the
+// new spec is created first, then the old node pool is drained and replaced
+// by the new one with the same name, and finally the surge node pool is
+// drained and removed.
+//
+// The nodes are therefore replaced twice. This is deliberate: Kubernikus node
+// pool names are immutable, and the name is the node pool identity in the
+// configuration and the state, and in the node labels used by the workload
+// node selectors. Keeping the surge node pool would rename the node pool.
+func kubernikusRolloverNodePoolV1(ctx context.Context, klient *kubernikus, cluster *models.Kluster, current []models.NodePool, oldPool, newPool models.NodePool, target string, pending []string, timeout time.Duration) error {
+	surge := newPool
+	surge.Name = kubernikusSurgeNodePoolName(newPool.Name)
+	if _, p := kubernikusFindNodePoolV1(current, surge.Name); p != nil {
+		return fmt.Errorf("cannot roll over %q node pool: %q surge node pool already exists", newPool.Name, surge.Name)
+	}
+
+	oldDown := oldPool
+	oldDown.Size = 0
+	surgeDown := surge
+	surgeDown.Size = 0
+	replaced := kubernikusReplaceNodePoolV1(current, oldPool.Name, &newPool)
+
+	steps := []struct {
+		msg   string
+		pools []models.NodePool
+	}{
+		{"creating surge node pool", kubernikusAppendNodePoolV1(current, surge)},
+		{"downscaling old node pool", kubernikusAppendNodePoolV1(kubernikusReplaceNodePoolV1(current, oldPool.Name, &oldDown), surge)},
+		{"deleting old node pool", kubernikusAppendNodePoolV1(kubernikusReplaceNodePoolV1(current, oldPool.Name, nil), surge)},
+		{"creating new node pool", kubernikusAppendNodePoolV1(replaced, surge)},
+		{"downscaling surge node pool", kubernikusAppendNodePoolV1(replaced, surgeDown)},
+		{"deleting surge node pool", replaced},
+	}
+
+	if klient.nodePools != nil {
+		klient.nodePools.managed[surge.Name] = true
+	}
+
+	for i, step := range steps {
+		log.Printf("[DEBUG] Rolling over %q node pool (step %d of %d): %s %q", newPool.Name, i+1, len(steps), step.msg, surge.Name)
+		cluster.Spec.NodePools = step.pools
+		err := kubernikusUpdateAndWait(ctx, klient, cluster, target, pending, timeout)
+		if err != nil {
+			return fmt.Errorf("error rolling over %q node pool while %s: %v", newPool.Name, step.msg, err)
+		}
+	}
+
+	return nil
+}
+
+// kubernikusSurgeNodePoolName returns the name of the temporary node pool
+// used during the node pool rollover.
+func kubernikusSurgeNodePoolName(name string) string {
+	if n := poolNameMaxLen - len(kubernikusSurgePoolSuffix); len(name) > n {
+		name = name[:n]
+	}
+	return strings.TrimRight(name, "-.") + kubernikusSurgePoolSuffix
+}
+
+// kubernikusReplaceNodePoolV1 returns a copy of the node pools, where the
+// named node pool is replaced by p, or removed if p is nil.
+func kubernikusReplaceNodePoolV1(nodePools []models.NodePool, name string, p *models.NodePool) []models.NodePool {
+	res := make([]models.NodePool, 0, len(nodePools))
+	for _, v := range nodePools {
+		if v.Name != name {
+			res = append(res, v)
+		} else if p != nil {
+			res = append(res, *p)
+		}
+	}
+	return res
+}
+
+// kubernikusAppendNodePoolV1 returns a copy of the node pools with p appended.
+func kubernikusAppendNodePoolV1(nodePools []models.NodePool, p models.NodePool) []models.NodePool {
+	res := make([]models.NodePool, len(nodePools), len(nodePools)+1)
+	copy(res, nodePools)
+	return append(res, p)
+}
+
+// kubernikusExpandNodePoolsRolloverV1 returns the rollover strategy per node
+// pool name.
+func kubernikusExpandNodePoolsRolloverV1(raw interface{}) map[string]string {
+	res := make(map[string]string)
+	if v, ok := raw.([]interface{}); ok {
+		for _, v := range v {
+			if v, ok := v.(map[string]interface{}); ok {
+				name, _ := v["name"].(string)
+				if c, ok := v["config"].([]interface{}); ok && len(c) > 0 {
+					if c, ok := c[0].(map[string]interface{}); ok {
+						res[name], _ = c["rollover_strategy"].(string)
+					}
+				}
+			}
+		}
+	}
+	return res
+}
+
+// kubernikusExpandNodePoolsSkipHealthyV1 returns the names of the node pools,
+// which have the "wait_for_healthy" option disabled.
+func kubernikusExpandNodePoolsSkipHealthyV1(raw interface{}) map[string]bool {
+	res := make(map[string]bool)
+	if v, ok := raw.([]interface{}); ok {
+		for _, v := range v {
+			if v, ok := v.(map[string]interface{}); ok {
+				name, _ := v["name"].(string)
+				if wait, ok := v["wait_for_healthy"].(bool); ok && !wait {
+					res[name] = true
+				}
+			}
+		}
+	}
+	return res
+}
+
+// kubernikusNodePoolSizeOptionsV1 are the node pool size options, which are
+// handled by the provider.
+type kubernikusNodePoolSizeOptionsV1 struct {
+	external bool
+	min      int
+	max      int
+}
+
+func kubernikusExpandNodePoolsSizeOptionsV1(raw interface{}) map[string]kubernikusNodePoolSizeOptionsV1 {
+	res := make(map[string]kubernikusNodePoolSizeOptionsV1)
+	if v, ok := raw.([]interface{}); ok {
+		for _, v := range v {
+			if v, ok := v.(map[string]interface{}); ok {
+				name, _ := v["name"].(string)
+				var o kubernikusNodePoolSizeOptionsV1
+				o.external, _ = v["size_managed_externally"].(bool)
+				o.min, _ = v["min_size"].(int)
+				o.max, _ = v["max_size"].(int)
+				res[name] = o
+			}
+		}
+	}
+	return res
+}
+
+// kubernikusKeepExternalNodePoolsSizeV1 replaces the size of the externally
+// scaled node pools with their live size, so that the provider never rewrites
+// it.
+func kubernikusKeepExternalNodePoolsSizeV1(klient *kubernikus, name string, options map[string]kubernikusNodePoolSizeOptionsV1, nodePools ...[]models.NodePool) error {
+	var external bool
+	for _, o := range options {
+		external = external || o.external
+	}
+	if !external {
+		return nil
+	}
+
+	result, err := klient.ShowCluster(operations.NewShowClusterParams().WithName(name), klient.authFunc())
+	if err != nil {
+		return kubernikusHandleErrorV1("Error reading Kubernikus cluster", err)
+	}
+
+	for _, pools := range nodePools {
+		for i, p := range pools {
+			if !options[p.Name].external {
+				continue
+			}
+			if _, live := kubernikusFindNodePoolV1(result.Payload.Spec.NodePools, p.Name); live != nil {
+				log.Printf("[DEBUG] Keeping the live size %d of the externally scaled %s node pool", live.Size, p.Name)
+				pools[i].Size = live.Size
+			}
+		}
+	}
+
+	return nil
+}
+
+// kubernikusSuppressExternalSizeDiffV1 suppresses the size diff of the
+// existing node pools, which are scaled externally.
+func kubernikusSuppressExternalSizeDiffV1(k, old, new string, d *schema.ResourceData) bool {
+	prefix := strings.TrimSuffix(k, ".size")
+	if o, n := d.GetChange(prefix + ".name"); o.(string) == "" || o != n {
+		return false
+	}
+	return d.Get(prefix + ".size_managed_externally").(bool)
+}
+
+// kubernikusKeepNodePoolsOptionsV1 copies the node pool options, which are
+// handled by the provider and not stored in Kubernikus, from the resource
+// data into the flattened node pools.
+func kubernikusKeepNodePoolsOptionsV1(d *schema.ResourceData, nodePools []map[string]interface{}) {
+	rollover := kubernikusExpandNodePoolsRolloverV1(d.Get("node_pools"))
+	skipHealthy := kubernikusExpandNodePoolsSkipHealthyV1(d.Get("node_pools"))
+	sizeOptions := kubernikusExpandNodePoolsSizeOptionsV1(d.Get("node_pools"))
+	for _, p := range nodePools {
+		p["wait_for_healthy"] = !skipHealthy[p["name"].(string)]
+
+		o := sizeOptions[p["name"].(string)]
+		p["size_managed_externally"] = o.external
+		p["min_size"] = o.min
+		p["max_size"] = o.max
+
+		v := rollover[p["name"].(string)]
+		if v == "" {
+			v = kubernikusRolloverRecreate
+		}
+		if c, ok := p["config"].([]map[string]interface{}); ok && len(c) > 0 {
+			c[0]["rollover_strategy"] = v
+		}
+	}
+}
+
 func kubernikusHandleErrorV1(msg string, err error) error {
 	switch res := err.(type) {
 	case *operations.TerminateClusterDefault:
@@ -349,8 +1073,28 @@ func kubernikusHandleErrorV1(msg string, err error) error {
 	return err
 }
 
-func kubernikusUpdateAndWait(ctx context.Context, klient *kubernikus, cluster *models.Kluster, target string, pending []string, timeout time.Duration) error {
+// kubernikusUpdateClusterV1 updates the cluster. The live node pools, which
+// are not managed by the cluster resource, are merged into the update under
+// the per-cluster lock, when the client has the node pools lock set.
+func kubernikusUpdateClusterV1(klient *kubernikus, cluster *models.Kluster) error {
+	if l := klient.nodePools; l != nil {
+		l.config.MutexKV.Lock(l.key)
+		defer l.config.MutexKV.Unlock(l.key)
+
+		result, err := klient.ShowCluster(operations.NewShowClusterParams().WithName(cluster.Name), klient.authFunc())
+		if err != nil {
+			return err
+		}
+		cluster.Spec.NodePools = kubernikusMergeNodePoolsV1(cluster.Spec.NodePools, result.Payload.Spec.NodePools, l.managed)
+	}
+
 	_, err := klient.UpdateCluster(operations.NewUpdateClusterParams().WithName(cluster.Name).WithBody(cluster), klient.authFunc())
+
+	return err
+}
+
+func kubernikusUpdateAndWait(ctx context.Context, klient *kubernikus, cluster *models.Kluster, target string, pending []string, timeout time.Duration) error {
+	err := kubernikusUpdateClusterV1(klient, cluster)
 	if err != nil {
 		return kubernikusHandleErrorV1("Error updating cluster", err)
 	}
@@ -363,7 +1107,7 @@ func kubernikusUpdateAndWait(ctx context.Context, klient *kubernikus, cluster *m
 	return nil
 }
 
-func getCredentials(klient *kubernikus, name string, creds string) (string, []map[string]string, error) {
+func getCredentials(klient *kubernikus, name string, creds string, renewBefore time.Duration) (string, []map[string]string, error) {
 	var err error
 	var kubeConfig []map[string]string
 	var crt *x509.Certificate
@@ -378,11 +1122,10 @@ func getCredentials(klient *kubernikus, name string, creds string) (string, []ma
 		if err != nil {
 			return "", nil, err
 		}
-
-		// Check so that the certificate is valid now
-		now := time.Now()
-		if now.Before(crt.NotBefore) || now.After(crt.NotAfter) {
-			log.Printf("[DEBUG] The Kubernikus certificate is not valid")
+		// Check so that the certificate is valid now and is not going to
+		// expire within the renewal window
+		if kubernikusCredentialsNeedRenewalV1(crt.NotBefore, crt.NotAfter, renewBefore) {
+			log.Printf("[DEBUG] The Kubernikus certificate is not valid or expires within %s", renewBefore)
 			creds, kubeConfig, err = downloadCredentials(klient, name)
 			if err != nil {
 				return "", nil, err
@@ -393,6 +1136,85 @@ func getCredentials(klient *kubernikus, name string, creds string) (string, []ma
 	return creds, kubeConfig, nil
 }
 
+// kubernikusRenewKubeConfigDiffV1 marks the credentials as changed, when the
+// stored certificate expires within the renewal window, e.g. when the plan
+// was created without a refresh.
+func kubernikusRenewKubeConfigDiffV1(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.NewValueKnown("kube_config_renew_before") {
+		return nil
+	}
+
+	notBefore, err1 := time.Parse(time.RFC3339, diff.Get("kube_config.0.not_before").(string))
+	notAfter, err2 := time.Parse(time.RFC3339, diff.Get("kube_config.0.not_after").(string))
+	if err1 != nil || err2 != nil {
+		// no credentials yet
+		return nil
+	}
+
+	renewBefore, _ := time.ParseDuration(diff.Get("kube_config_renew_before").(string))
+	if !kubernikusCredentialsNeedRenewalV1(notBefore, notAfter, renewBefore) {
+		return nil
+	}
+
+	for _, k := range []string{"kube_config", "kube_config_raw", "kube_config_expires_in"} {
+		if err := diff.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func kubernikusCredentialsNeedRenewalV1(notBefore, notAfter time.Time, renewBefore time.Duration) bool {
+	now := time.Now()
+	renewBefore = kubernikusRenewBeforeV1(notBefore, notAfter, renewBefore)
+	return now.Before(notBefore) || now.Add(renewBefore).After(notAfter)
+}
+
+// kubernikusRenewBeforeV1 returns the renewal window capped at the half of the
+// certificate lifetime. A window, which covers the whole lifetime, would rotate
+// the credentials on every refresh.
+func kubernikusRenewBeforeV1(notBefore, notAfter time.Time, renewBefore time.Duration) time.Duration {
+	if limit := notAfter.Sub(notBefore) / 2; renewBefore > limit {
+		return limit
+	}
+	return renewBefore
+}
+
+// kubernikusRenewBeforeDiagnosticsV1 returns a warning, when the renewal
+// window exceeds the half of the flattened kubeconfig certificate lifetime.
+func kubernikusRenewBeforeDiagnosticsV1(kubeConfig []map[string]string, renewBefore time.Duration) diag.Diagnostics {
+	for _, v := range kubeConfig {
+		notBefore, err1 := time.Parse(time.RFC3339, v["not_before"])
+		notAfter, err2 := time.Parse(time.RFC3339, v["not_after"])
+		if err1 != nil || err2 != nil {
+			return nil
+		}
+		if limit := kubernikusRenewBeforeV1(notBefore, notAfter, renewBefore); limit < renewBefore {
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "kube_config_renew_before is capped",
+				Detail: fmt.Sprintf("The %s renewal window exceeds the half of the %s credentials lifetime, %s is used instead.",
+					renewBefore, notAfter.Sub(notBefore), limit),
+			}}
+		}
+	}
+	return nil
+}
+
+// kubernikusKubeConfigExpiresInV1 returns the amount of seconds until the
+// flattened kubeconfig credentials expire.
+func kubernikusKubeConfigExpiresInV1(kubeConfig []map[string]string) int {
+	for _, v := range kubeConfig {
+		notAfter, err := time.Parse(time.RFC3339, v["not_after"])
+		if err != nil {
+			return 0
+		}
+		return int(time.Until(notAfter).Seconds())
+	}
+	return 0
+}
+
 func flattenKubernetesClusterKubeConfig(creds string) ([]map[string]string, *x509.Certificate, error) {
 	var cfg clientcmdapi.Config
 	var values = make(map[string]string)
@@ -447,6 +1269,250 @@ func downloadCredentials(klient *kubernikus, name string) (string, []map[string]
 	return credentials.Payload.Kubeconfig, kubeConfig, nil
 }
 
+// kubernikusValidateVersionChangeV1 verifies that the new version is
+// available in Kubernikus and, when the cluster already exists, that it is a
+// single step upgrade or, when stepwise is set, that all intermediate minor
+// versions are available.
+func kubernikusValidateVersionChangeV1(klient *kubernikus, oldVersion, newVersion string, stepwise bool) error {
+	if err := verifySupportedKubernetesVersion(klient, newVersion); err != nil {
+		return err
+	}
+
+	if oldVersion == "" {
+		return nil
+	}
+
+	from, err := goversion.NewVersion(oldVersion)
+	if err != nil {
+		return fmt.Errorf("failed to parse the current %q Kubernetes version: %s", oldVersion, err)
+	}
+	to, err := goversion.NewVersion(newVersion)
+	if err != nil {
+		return fmt.Errorf("failed to parse the %q Kubernetes version: %s", newVersion, err)
+	}
+
+	if to.LessThan(from) {
+		return fmt.Errorf("downgrading the cluster from %q to %q is not supported", oldVersion, newVersion)
+	}
+	if !kubernikusIsUpgradePathV1(from, to) {
+		if stepwise {
+			info, err := klient.Info(nil)
+			if err != nil {
+				return fmt.Errorf("failed to check supported Kubernetes versions: %s", err)
+			}
+			_, err = kubernikusUpgradeStepsV1(info.Payload.AvailableClusterVersions, oldVersion, newVersion)
+			return err
+		}
+		f := from.Segments()
+		return fmt.Errorf("upgrading the cluster from %q to %q skips minor versions, upgrade to %d.%d first or use the %q upgrade_strategy", oldVersion, newVersion, f[0], f[1]+1, kubernikusUpgradeStepwise)
+	}
+
+	return nil
+}
+
+// kubernikusClusterForceNewKeysV1 returns the ForceNew keys of the cluster
+// schema, which are computed once.
+var kubernikusClusterForceNewKeysV1 func() []string
+
+func init() {
+	kubernikusClusterForceNewKeysV1 = sync.OnceValue(func() []string {
+		return kubernikusForceNewKeysV1(resourceSCIKubernetesV1().Schema, "")
+	})
+}
+
+// kubernikusValidateDeletionProtectionV1 rejects the changes of the ForceNew
+// keys, which would replace the protected cluster. The protection is
+// effective, when it is enabled either in the state or in the configuration.
+func kubernikusValidateDeletionProtectionV1(diff *schema.ResourceDiff, forceNewKeys []string) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if o, n := diff.GetChange("deletion_protection"); !o.(bool) && !n.(bool) {
+		return nil
+	}
+
+	var keys []string
+	for _, k := range forceNewKeys {
+		if diff.HasChange(k) {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) > 0 {
+		return fmt.Errorf("cannot replace the %s cluster, because deletion_protection is enabled: changing %s requires a new cluster", diff.Id(), strings.Join(keys, ", "))
+	}
+
+	return nil
+}
+
+// kubernikusForceNewKeysV1 returns the keys of the ForceNew attributes,
+// including the attributes of the nested single item blocks.
+func kubernikusForceNewKeysV1(s map[string]*schema.Schema, prefix string) []string {
+	var keys []string
+	for k, v := range s {
+		if v.ForceNew {
+			keys = append(keys, prefix+k)
+			continue
+		}
+		if r, ok := v.Elem.(*schema.Resource); ok && v.Type == schema.TypeList && v.MaxItems == 1 {
+			keys = append(keys, kubernikusForceNewKeysV1(r.Schema, prefix+k+".0.")...)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// kubernikusValidateNodePoolNamesV1 returns an error for every node pool,
+// which reuses the name of a previous node pool.
+func kubernikusValidateNodePoolNamesV1(diff *schema.ResourceDiff) []error {
+	var errs []error
+	var names []string
+	for i := range diff.Get("node_pools").([]interface{}) {
+		key := fmt.Sprintf("node_pools.%d.name", i)
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+		name := diff.Get(key).(string)
+		if strSliceContains(names, name) {
+			errs = append(errs, fmt.Errorf("%s: duplicate node pool name found: %s", key, name))
+		}
+		names = append(names, name)
+	}
+	return errs
+}
+
+// kubernikusValidateNodePoolsSizeV1 validates the size bounds of the
+// externally scaled node pools. The size of the existing node pools is the
+// live size, because its diff is suppressed.
+func kubernikusValidateNodePoolsSizeV1(diff *schema.ResourceDiff) []error {
+	var errs []error
+	for i := range diff.Get("node_pools").([]interface{}) {
+		prefix := fmt.Sprintf("node_pools.%d", i)
+		minSize := diff.Get(prefix + ".min_size").(int)
+		maxSize := diff.Get(prefix + ".max_size").(int)
+		if minSize == 0 && maxSize == 0 {
+			continue
+		}
+		if !diff.Get(prefix + ".size_managed_externally").(bool) {
+			errs = append(errs, fmt.Errorf("%s: min_size and max_size require size_managed_externally to be enabled", prefix))
+			continue
+		}
+		if maxSize > 0 && minSize > maxSize {
+			errs = append(errs, fmt.Errorf("%s.min_size: %d is greater than max_size %d", prefix, minSize, maxSize))
+			continue
+		}
+		if !diff.NewValueKnown(prefix + ".size") {
+			continue
+		}
+		size := diff.Get(prefix + ".size").(int)
+		if size < minSize {
+			errs = append(errs, fmt.Errorf("%s.size: %d is lower than min_size %d", prefix, size, minSize))
+		}
+		if maxSize > 0 && size > maxSize {
+			errs = append(errs, fmt.Errorf("%s.size: %d is greater than max_size %d", prefix, size, maxSize))
+		}
+	}
+	return errs
+}
+
+// kubernikusValidateNodePoolsMetadataV1 verifies the changed node pool flavors
+// and availability zones against the Kubernikus OpenStack metadata and the
+// changed node pool images against the Glance images. Images are not part of
+// the metadata and must be listed by the caller, when
+// kubernikusNodePoolImagesChangedV1 returns true.
+func kubernikusValidateNodePoolsMetadataV1(diff *schema.ResourceDiff, m *models.OpenstackMetadata, imgs []images.Image) []error {
+	if m == nil {
+		return nil
+	}
+
+	var flavors []string
+	for _, f := range m.Flavors {
+		flavors = append(flavors, f.Name)
+	}
+	azs := kubernikusFlattenMetadataAvailabilityZonesV1(m)
+
+	var errs []error
+	for i := range diff.Get("node_pools").([]interface{}) {
+		key := fmt.Sprintf("node_pools.%d.flavor", i)
+		if diff.HasChange(key) && diff.NewValueKnown(key) {
+			if v := diff.Get(key).(string); !kubernikusMetadataHasFlavorV1(m, v) {
+				errs = append(errs, fmt.Errorf("%s: flavor %q doesn't exist, available flavors: %q", key, v, flavors))
+			}
+		}
+
+		key = fmt.Sprintf("node_pools.%d.availability_zone", i)
+		if diff.HasChange(key) && diff.NewValueKnown(key) {
+			if v := diff.Get(key).(string); v != "" && !strSliceContains(azs, v) {
+				errs = append(errs, fmt.Errorf("%s: availability zone %q doesn't exist, available availability zones: %q", key, v, azs))
+			}
+		}
+
+		key = fmt.Sprintf("node_pools.%d.image", i)
+		if kubernikusNodePoolImageChangedV1(diff, key) {
+			if v := diff.Get(key).(string); !kubernikusHasImageV1(imgs, v) {
+				errs = append(errs, fmt.Errorf("%s: image %q doesn't exist or is not active, see the images of the sci_kubernetes_openstack_metadata_v1 data source", key, v))
+			}
+		}
+	}
+	return errs
+}
+
+// kubernikusNodePoolImagesChangedV1 returns true, when a node pool image has
+// to be verified.
+func kubernikusNodePoolImagesChangedV1(diff *schema.ResourceDiff) bool {
+	for i := range diff.Get("node_pools").([]interface{}) {
+		if kubernikusNodePoolImageChangedV1(diff, fmt.Sprintf("node_pools.%d.image", i)) {
+			return true
+		}
+	}
+	return false
+}
+
+func kubernikusNodePoolImageChangedV1(diff *schema.ResourceDiff, key string) bool {
+	return diff.HasChange(key) && diff.NewValueKnown(key) && diff.Get(key).(string) != ""
+}
+
+func kubernikusHasImageV1(imgs []images.Image, image string) bool {
+	for _, v := range imgs {
+		if v.Name == image || v.ID == image {
+			return true
+		}
+	}
+	return false
+}
+
+// kubernikusListImagesV1 returns the active Glance images, which are visible
+// to the project and can be used for the node pools.
+func kubernikusListImagesV1(ctx context.Context, config *Config, region string) ([]images.Image, error) {
+	client, err := config.ImageV2Client(ctx, region)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	opts := images.ListOpts{
+		Status: images.ImageStatusActive,
+	}
+	pages, err := images.List(client, opts).AllPages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing OpenStack images: %s", err)
+	}
+
+	res, err := images.ExtractImages(pages)
+	if err != nil {
+		return nil, fmt.Errorf("Error extracting OpenStack images: %s", err)
+	}
+
+	return res, nil
+}
+
+func kubernikusMetadataHasFlavorV1(m *models.OpenstackMetadata, flavor string) bool {
+	for _, f := range m.Flavors {
+		if f.Name == flavor || f.ID == flavor {
+			return true
+		}
+	}
+	return false
+}
+
 func verifySupportedKubernetesVersion(klient *kubernikus, version string) error {
 	if info, err := klient.Info(nil); err != nil {
 		return fmt.Errorf("failed to check supported Kubernetes versions: %s", err)
@@ -455,3 +1521,133 @@ func verifySupportedKubernetesVersion(klient *kubernikus, version string) error
 	}
 	return nil
 }
+
+// kubernikusSortVersionsV1 returns the valid semantic versions from the list
+// in ascending order.
+func kubernikusSortVersionsV1(versions []string) []*goversion.Version {
+	res := make([]*goversion.Version, 0, len(versions))
+	for _, v := range versions {
+		ver, err := goversion.NewVersion(v)
+		if err != nil {
+			log.Printf("[DEBUG] Skipping invalid Kubernikus version %q: %s", v, err)
+			continue
+		}
+		res = append(res, ver)
+	}
+	sort.Sort(goversion.Collection(res))
+	return res
+}
+
+// kubernikusIsUpgradePathV1 reports whether a cluster can be upgraded from one
+// version to another in a single step. Kubernikus allows patch upgrades and
+// upgrades to the next minor version only.
+func kubernikusIsUpgradePathV1(from, to *goversion.Version) bool {
+	f, t := from.Segments(), to.Segments()
+	if f[0] != t[0] || !to.GreaterThan(from) {
+		return false
+	}
+	return t[1] == f[1] || t[1] == f[1]+1
+}
+
+// kubernikusUpgradeStepsV1 returns the versions to upgrade the cluster
+// through, one per minor version. The intermediate steps are the latest
+// available patch versions, the last step is the target version.
+func kubernikusUpgradeStepsV1(available []string, fromVersion, toVersion string) ([]string, error) {
+	from, err := goversion.NewVersion(fromVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the current %q Kubernetes version: %s", fromVersion, err)
+	}
+	to, err := goversion.NewVersion(toVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the %q Kubernetes version: %s", toVersion, err)
+	}
+
+	if to.LessThan(from) {
+		return nil, fmt.Errorf("downgrading the cluster from %q to %q is not supported", fromVersion, toVersion)
+	}
+	f, t := from.Segments(), to.Segments()
+	if f[0] != t[0] {
+		return nil, fmt.Errorf("upgrading the cluster from %q to %q changes the major version", fromVersion, toVersion)
+	}
+
+	versions := kubernikusSortVersionsV1(available)
+	var steps []string
+	for minor := f[1] + 1; minor < t[1]; minor++ {
+		var step string
+		for _, v := range versions {
+			if s := v.Segments(); s[0] == f[0] && s[1] == minor {
+				step = v.Original()
+			}
+		}
+		if step == "" {
+			return nil, fmt.Errorf("upgrading the cluster from %q to %q requires a %d.%d version, which is not available", fromVersion, toVersion, f[0], minor)
+		}
+		steps = append(steps, step)
+	}
+
+	return append(steps, toVersion), nil
+}
+
+// kubernikusUpgradeStepwiseV1 upgrades the cluster through the intermediate
+// minor versions preceding the target version. The target version itself is
+// set by the regular update. The steps start from the live cluster version,
+// therefore an interrupted upgrade is resumed. The timeout applies to all
+// steps together.
+func kubernikusUpgradeStepwiseV1(ctx context.Context, klient *kubernikus, name, version string, pending []string, timeout time.Duration) error {
+	target := string(models.KlusterPhaseRunning)
+	deadline := time.Now().Add(timeout)
+
+	// wait for the previous upgrade step to be finished
+	err := kubernikusWaitForClusterV1(ctx, klient, name, target, pending, time.Until(deadline))
+	if err != nil {
+		return kubernikusHandleErrorV1("Error waiting for cluster to be upgraded", err)
+	}
+
+	result, err := klient.ShowCluster(operations.NewShowClusterParams().WithName(name), klient.authFunc())
+	if err != nil {
+		return kubernikusHandleErrorV1("Error reading Kubernikus cluster", err)
+	}
+
+	info, err := klient.Info(nil)
+	if err != nil {
+		return fmt.Errorf("failed to check supported Kubernetes versions: %s", err)
+	}
+
+	steps, err := kubernikusUpgradeStepsV1(info.Payload.AvailableClusterVersions, result.Payload.Spec.Version, version)
+	if err != nil {
+		return err
+	}
+
+	for i, step := range steps[:len(steps)-1] {
+		log.Printf("[KUBERNETES] Upgrading Kubernikus cluster %s to %s, step %d of %d", name, step, i+1, len(steps))
+		cluster := &models.Kluster{
+			Name: name,
+			Spec: result.Payload.Spec,
+		}
+		cluster.Spec.Version = step
+		err = kubernikusUpdateAndWait(ctx, klient, cluster, target, pending, time.Until(deadline))
+		if err != nil {
+			return err
+		}
+	}
+	log.Printf("[KUBERNETES] Upgrading Kubernikus cluster %s to %s, step %d of %d", name, version, len(steps), len(steps))
+
+	return nil
+}
+
+func kubernikusFlattenUpgradePathsV1(versions []*goversion.Version) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(versions))
+	for _, from := range versions {
+		to := make([]string, 0)
+		for _, v := range versions {
+			if kubernikusIsUpgradePathV1(from, v) {
+				to = append(to, v.Original())
+			}
+		}
+		res = append(res, map[string]interface{}{
+			"from": from.Original(),
+			"to":   to,
+		})
+	}
+	return res
+}
@@ -0,0 +1,181 @@
+package sci
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	osClient "github.com/gophercloud/utils/v2/client"
+)
+
+const retryBaseDelay = 1 * time.Second
+
+// retryStatusCodes are the HTTP response codes, which are retried by the
+// service clients for all request methods. The request was not processed by
+// the backend, e.g. a 409 is returned for objects in a PENDING_* state.
+var retryStatusCodes = []int{
+	http.StatusConflict,
+	http.StatusTooManyRequests,
+	http.StatusServiceUnavailable,
+}
+
+// retryIdempotentStatusCodes are the HTTP response codes, which are retried
+// for idempotent requests only. The backend may have already processed the
+// request, e.g. a 504 after an accepted POST, and a retry would create a
+// duplicate object.
+var retryIdempotentStatusCodes = []int{
+	http.StatusBadGateway,
+	http.StatusGatewayTimeout,
+}
+
+// idempotentMethods are the HTTP request methods, which are safe to retry on
+// any of the retryIdempotentStatusCodes.
+var idempotentMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodOptions,
+}
+
+// newServiceHTTPClient returns an HTTP client for the go-openapi based
+// service clients (Kubernikus, Andromeda and Archer). It shares the TLS,
+// client certificate, proxy, retry and extra header settings with the
+// OpenStack provider client, so that all services behave the same way.
+// On top of that it re-authenticates on 401 responses and retries
+// throttled or temporarily failed requests.
+func newServiceHTTPClient(c *Config, svc string) *http.Client {
+	rt := c.OsClient.HTTPClient.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	if v, ok := rt.(*osClient.RoundTripper); ok {
+		// go-openapi clients log the requests and responses on their own
+		tmp := *v
+		tmp.Logger = nil
+		rt = &tmp
+	}
+
+	return &http.Client{
+		Transport: &serviceRoundTripper{
+			rt:          rt,
+			svc:         svc,
+			provider:    c.OsClient,
+			allowReauth: c.AllowReauth,
+			maxRetries:  c.APIMaxRetries,
+			maxDelay:    c.APIRetryMaxDelay,
+		},
+	}
+}
+
+// serviceRoundTripper sets the provider User-Agent header on every request,
+// re-authenticates on expired tokens and retries requests with an
+// exponential backoff.
+type serviceRoundTripper struct {
+	rt          http.RoundTripper
+	svc         string
+	provider    *gophercloud.ProviderClient
+	allowReauth bool
+	maxRetries  int
+	maxDelay    time.Duration
+}
+
+func (rt *serviceRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := req.Context()
+	reauthenticated := false
+	token := ""
+	for retry := 0; ; retry++ {
+		r := req.Clone(ctx)
+		if body != nil {
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		r.Header.Set("User-Agent", rt.provider.UserAgent.Join())
+		if token != "" {
+			r.Header.Set("X-Auth-Token", token)
+		}
+
+		resp, err := rt.rt.RoundTrip(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode == http.StatusUnauthorized && rt.allowReauth && !reauthenticated {
+			reauthenticated = true
+			log.Printf("[DEBUG] %s request %s %s returned 401, re-authenticating", rt.svc, r.Method, r.URL)
+			drainBody(resp)
+			if err := rt.provider.Reauthenticate(ctx, r.Header.Get("X-Auth-Token")); err != nil {
+				return nil, fmt.Errorf("failed to re-authenticate %s request: %s", rt.svc, err)
+			}
+			token = rt.provider.Token()
+			// re-authentication doesn't count as a retry
+			retry--
+			continue
+		}
+
+		if !isRetryable(r.Method, resp.StatusCode) || retry >= rt.maxRetries {
+			return resp, nil
+		}
+
+		delay := rt.retryDelay(resp, retry)
+		log.Printf("[DEBUG] %s request %s %s returned %d, retry %d of %d in %s", rt.svc, r.Method, r.URL, resp.StatusCode, retry+1, rt.maxRetries, delay)
+		drainBody(resp)
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// isRetryable reports whether the response code of the request method is
+// retried.
+func isRetryable(method string, code int) bool {
+	if sliceContains(retryStatusCodes, code) {
+		return true
+	}
+	return sliceContains(idempotentMethods, method) && sliceContains(retryIdempotentStatusCodes, code)
+}
+
+// retryDelay returns the delay before the next retry. The Retry-After
+// response header takes precedence over the exponential backoff.
+func (rt *serviceRoundTripper) retryDelay(resp *http.Response, retry int) time.Duration {
+	delay := time.Duration(float64(retryBaseDelay) * math.Pow(2, float64(retry)))
+
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if s, err := strconv.ParseUint(v, 10, 32); err == nil {
+			delay = time.Duration(s) * time.Second
+		} else if t, err := http.ParseTime(v); err == nil {
+			delay = time.Until(t)
+		}
+	}
+
+	if delay < 0 {
+		delay = 0
+	}
+	if rt.maxDelay > 0 && delay > rt.maxDelay {
+		delay = rt.maxDelay
+	}
+
+	return delay
+}
+
+func drainBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}